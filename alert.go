@@ -0,0 +1,185 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AlertWatcherOption defines a function signature to update the alert watcher configuration.
+type AlertWatcherOption func(*AlertWatcher)
+
+// WithAlertRules adds rules to the alert watcher.
+func WithAlertRules(rules ...AlertRule) AlertWatcherOption {
+	return func(w *AlertWatcher) {
+		w.rules = append(w.rules, rules...)
+	}
+}
+
+// WithAlertClock sets the function used to get the current time. Default is time.Now.
+func WithAlertClock(now func() time.Time) AlertWatcherOption {
+	return func(w *AlertWatcher) {
+		w.now = now
+	}
+}
+
+// AlertRule triggers an alert when at least Threshold entries at or above
+// Level are logged within a sliding window, and recovers when it is no
+// longer the case.
+type AlertRule struct {
+	// Name identifies the rule, it is not used by the watcher.
+	Name string
+	// Level is the minimum level of the counted entries.
+	Level Level
+	// Threshold is the number of entries within Window that triggers the alert.
+	Threshold int
+	// Window is the duration of the sliding window.
+	Window time.Duration
+	// OnTrigger, if set, is called when the alert is triggered.
+	OnTrigger func(AlertEvent)
+	// OnRecover, if set, is called when the alert recovers.
+	OnRecover func(AlertEvent)
+}
+
+// AlertEvent is given to the callbacks of an alert rule.
+type AlertEvent struct {
+	Rule AlertRule
+	Time time.Time
+	// Count is the number of entries within the window, up to the threshold.
+	Count int
+}
+
+// AlertWatcher is a logger that tracks the rate of the entries logged
+// through it, and through all the loggers derived from it, and calls
+// the callbacks of its rules when their thresholds are crossed.
+//
+// Rules are evaluated each time an entry is logged, whatever its level.
+// To detect recoveries while nothing is logged, Check can be called
+// periodically. Callbacks are called synchronously, after the entry is
+// written. They may log through the watcher, these entries are counted
+// like any other.
+type AlertWatcher struct {
+	Logger
+
+	now    func() time.Time
+	rules  []AlertRule
+	lock   sync.Mutex
+	alerts []*alert
+}
+
+// NewAlertWatcher returns an alert watcher on top of the provided logger.
+func NewAlertWatcher(l Logger, opts ...AlertWatcherOption) (*AlertWatcher, error) {
+	w := &AlertWatcher{now: time.Now}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	for _, rule := range w.rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("invalid alert rule %q: %w", rule.Name, err)
+		}
+		w.alerts = append(w.alerts, &alert{rule: rule, times: make([]time.Time, rule.Threshold)})
+	}
+
+	w.Logger = w.wrap(l)
+	return w, nil
+}
+
+// Check evaluates the rules without logging any entry.
+func (w *AlertWatcher) Check() {
+	w.observe(LevelQuiet)
+}
+
+func (w *AlertWatcher) wrap(l Logger) Logger {
+	return &wrapper{Logger: l, handle: w.handle, wrap: w.wrap}
+}
+
+func (w *AlertWatcher) handle(next Logger, e entry) {
+	e.writeTo(next)
+	w.observe(e.level)
+}
+
+// observe records an entry of the provided level, LevelQuiet records nothing,
+// and calls the callbacks of the rules whose state changed. Callbacks are
+// called outside of the lock, to let them use the watcher.
+func (w *AlertWatcher) observe(lvl Level) {
+	now := w.now()
+
+	var callbacks []func()
+
+	w.lock.Lock()
+	for _, a := range w.alerts {
+		if lvl >= a.rule.Level && lvl < LevelQuiet {
+			a.record(now)
+		}
+		if callback := a.update(now); callback != nil {
+			callbacks = append(callbacks, callback)
+		}
+	}
+	w.lock.Unlock()
+
+	for _, callback := range callbacks {
+		callback()
+	}
+}
+
+func (r AlertRule) validate() error {
+	if r.Level < LevelDebug || r.Level > LevelError {
+		return fmt.Errorf("invalid level %s", r.Level)
+	}
+	if r.Threshold < 1 {
+		return fmt.Errorf("threshold must be positive, got %d", r.Threshold)
+	}
+	if r.Window <= 0 {
+		return fmt.Errorf("window must be positive, got %s", r.Window)
+	}
+	return nil
+}
+
+// alert is the state of a rule, it keeps the time of the last
+// Threshold entries which is enough to know whether the threshold is reached.
+type alert struct {
+	rule      AlertRule
+	times     []time.Time
+	next      int
+	triggered bool
+}
+
+func (a *alert) record(now time.Time) {
+	a.times[a.next] = now
+	a.next = (a.next + 1) % len(a.times)
+}
+
+// count returns the number of recorded entries within the window.
+func (a *alert) count(now time.Time) int {
+	var count int
+	for _, t := range a.times {
+		if !t.IsZero() && now.Sub(t) < a.rule.Window {
+			count++
+		}
+	}
+	return count
+}
+
+// update returns the callback to call if the state of the alert changed.
+func (a *alert) update(now time.Time) func() {
+	count := a.count(now)
+
+	var callback func(AlertEvent)
+	switch reached := count >= a.rule.Threshold; {
+	case reached && !a.triggered:
+		a.triggered = true
+		callback = a.rule.OnTrigger
+	case !reached && a.triggered:
+		a.triggered = false
+		callback = a.rule.OnRecover
+	}
+
+	if callback == nil {
+		return nil
+	}
+
+	event := AlertEvent{Rule: a.rule, Time: now, Count: count}
+	return func() { callback(event) }
+}
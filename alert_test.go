@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewAlertWatcher(t *testing.T) {
+	var (
+		log    = NewInMemory(LevelInfo)
+		now    = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		events []string
+	)
+
+	record := func(kind string) func(AlertEvent) {
+		return func(event AlertEvent) {
+			events = append(events, kind+" "+event.Rule.Name+" "+event.Time.Format("15:04:05"))
+		}
+	}
+
+	watcher, err := NewAlertWatcher(log,
+		WithAlertClock(func() time.Time { return now }),
+		WithAlertRules(AlertRule{
+			Name:      "errors",
+			Level:     LevelError,
+			Threshold: 2,
+			Window:    time.Minute,
+			OnTrigger: record("trigger"),
+			OnRecover: record("recover"),
+		}, AlertRule{
+			Name:      "warnings",
+			Level:     LevelWarn,
+			Threshold: 3,
+			Window:    time.Minute,
+			OnTrigger: record("trigger"),
+		}),
+	)
+	require.NoError(t, err)
+
+	watcher.Error("error")
+	now = now.Add(40 * time.Second)
+	watcher.Named("db").WithField("a", 1).Warn("warn")
+	assert.Empty(t, events)
+
+	now = now.Add(30 * time.Second)
+	watcher.Errorf("error %d", 2)
+	assert.Empty(t, events, "the first error is out of the window")
+
+	now = now.Add(10 * time.Second)
+	watcher.Errorw("error", "answer", 42)
+	assert.Equal(t, []string{"trigger errors 00:01:20", "trigger warnings 00:01:20"}, events)
+
+	now = now.Add(10 * time.Second)
+	watcher.Error("error")
+	assert.Len(t, events, 2, "callbacks should only be called when the state changes")
+
+	now = now.Add(45 * time.Second)
+	watcher.Info("info")
+	assert.Len(t, events, 2, "two errors are still within the window")
+
+	now = now.Add(10 * time.Second)
+	watcher.Check()
+	assert.Equal(t, "recover errors 00:02:25", events[2])
+	require.Len(t, events, 3, "warnings rule has no recover callback")
+
+	assert.Len(t, log.Entries, 6, "entries should all be written")
+}
+
+func Test_NewAlertWatcher_invalidRule(t *testing.T) {
+	tests := map[string]AlertRule{
+		"level":     {Level: LevelQuiet, Threshold: 1, Window: time.Second},
+		"threshold": {Level: LevelError, Threshold: -1, Window: time.Second},
+		"window":    {Level: LevelError, Threshold: 1},
+	}
+
+	for name, rule := range tests {
+		rule := rule
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewAlertWatcher(new(Noop), WithAlertRules(rule))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_AlertWatcher_callbackCanLog(t *testing.T) {
+	log := NewInMemory(LevelInfo)
+
+	var watcher *AlertWatcher
+	watcher, err := NewAlertWatcher(log, WithAlertRules(AlertRule{
+		Level:     LevelError,
+		Threshold: 1,
+		Window:    time.Minute,
+		OnTrigger: func(AlertEvent) { watcher.Warn("too many errors") },
+	}))
+	require.NoError(t, err)
+
+	watcher.Error("error")
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, []interface{}{"too many errors"}, log.Entries[1].Args)
+}
@@ -0,0 +1,216 @@
+package logger
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy defines what an asynchronous logger does when its queue is full.
+type OverflowPolicy uint8
+
+const (
+	// OverflowBlock blocks the caller until there is room in the queue.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest drops the entry that could not be queued.
+	OverflowDropNewest
+	// OverflowDropOldest drops the oldest queued entry to make room for the new one.
+	OverflowDropOldest
+)
+
+// AsyncOption defines a function signature to update the asynchronous logger configuration.
+type AsyncOption func(*Async)
+
+// WithAsyncQueueSize sets the maximum number of queued entries. Default is 1024.
+// Sizes lower than 1 are set to 1.
+func WithAsyncQueueSize(size int) AsyncOption {
+	return func(a *Async) {
+		if size < 1 {
+			size = 1
+		}
+		a.queue = make(chan asyncEntry, size)
+	}
+}
+
+// WithAsyncOverflowPolicy sets what to do when the queue is full. Default is OverflowBlock.
+func WithAsyncOverflowPolicy(policy OverflowPolicy) AsyncOption {
+	return func(a *Async) {
+		a.policy = policy
+	}
+}
+
+// Async is a logger that queues entries and writes them to
+// the underlying logger from a background goroutine.
+// Lazy values are computed by the background goroutine, and
+// callers and stack traces can't be reported by the backends.
+type Async struct {
+	Logger
+
+	policy  OverflowPolicy
+	queue   chan asyncEntry
+	dropped atomic.Uint64
+
+	// closeLock makes sure no entries are queued once the logger is closed,
+	// senders tracks the callers queuing an entry which the background
+	// goroutine waits for before writing the remaining entries.
+	closeLock sync.RWMutex
+	closed    bool
+	senders   sync.WaitGroup
+	closeOnce sync.Once
+	closing   chan struct{}
+	flush     chan chan struct{}
+	done      chan struct{}
+}
+
+type asyncEntry struct {
+	next Logger
+	e    entry
+}
+
+// NewAsync returns a logger that writes entries to the provided logger asynchronously.
+// Close should be called to write the remaining entries and stop the background goroutine.
+func NewAsync(l Logger, opts ...AsyncOption) *Async {
+	a := &Async{
+		policy:  OverflowBlock,
+		queue:   make(chan asyncEntry, 1024),
+		closing: make(chan struct{}),
+		flush:   make(chan chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	a.Logger = a.wrap(l)
+	go a.run()
+
+	return a
+}
+
+// Dropped returns the number of entries dropped because the queue was full.
+func (a *Async) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Flush waits until all the entries queued before the call are written.
+func (a *Async) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+
+	select {
+	case a.flush <- flushed:
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close writes all the queued entries and stops the background goroutine.
+// Entries logged after Close are written synchronously.
+func (a *Async) Close(ctx context.Context) error {
+	a.closeOnce.Do(func() {
+		a.closeLock.Lock()
+		a.closed = true
+		a.closeLock.Unlock()
+
+		close(a.closing)
+	})
+
+	select {
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (a *Async) wrap(l Logger) Logger {
+	return &wrapper{Logger: l, handle: a.handle, wrap: a.wrap}
+}
+
+func (a *Async) handle(next Logger, e entry) {
+	if !next.Enabled(e.level) {
+		return
+	}
+
+	a.closeLock.RLock()
+	if a.closed {
+		a.closeLock.RUnlock()
+		e.writeTo(next)
+		return
+	}
+	a.senders.Add(1)
+	a.closeLock.RUnlock()
+	defer a.senders.Done()
+
+	item := asyncEntry{next: next, e: e}
+
+	switch a.policy {
+	case OverflowDropNewest:
+		select {
+		case a.queue <- item:
+		default:
+			a.dropped.Add(1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case a.queue <- item:
+				return
+			default:
+			}
+
+			select {
+			case <-a.queue:
+				a.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		// the lock is not held while blocked, Close would otherwise wait
+		// for the queue to have room, the entry is written synchronously
+		// if the logger gets closed in the meantime
+		select {
+		case a.queue <- item:
+		case <-a.closing:
+			e.writeTo(next)
+		}
+	}
+}
+
+func (a *Async) run() {
+	defer close(a.done)
+
+	for {
+		select {
+		case item := <-a.queue:
+			item.e.writeTo(item.next)
+		case flushed := <-a.flush:
+			a.drain()
+			close(flushed)
+		case <-a.closing:
+			a.senders.Wait()
+			a.drain()
+			return
+		}
+	}
+}
+
+func (a *Async) drain() {
+	for {
+		select {
+		case item := <-a.queue:
+			item.e.writeTo(item.next)
+		default:
+			return
+		}
+	}
+}
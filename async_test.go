@@ -0,0 +1,217 @@
+package logger
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingLogger blocks each Info call until unblock is closed.
+// Info calls are serialized as entries may be written synchronously
+// by callers while the background goroutine writes queued ones.
+type blockingLogger struct {
+	*InMemory
+	lock    sync.Mutex
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func newBlockingLogger() *blockingLogger {
+	return &blockingLogger{
+		InMemory: NewInMemory(LevelDebug),
+		started:  make(chan struct{}, 10),
+		unblock:  make(chan struct{}),
+	}
+}
+
+func (b *blockingLogger) Info(args ...interface{}) {
+	b.started <- struct{}{}
+	<-b.unblock
+	b.lock.Lock()
+	b.InMemory.Info(args...)
+	b.lock.Unlock()
+}
+
+func entriesArgs(entries []InMemoryEntry) []interface{} {
+	var args []interface{}
+	for _, entry := range entries {
+		args = append(args, entry.Args...)
+	}
+	return args
+}
+
+func Test_NewAsync(t *testing.T) {
+	log := NewInMemory(LevelInfo)
+	async := NewAsync(log)
+
+	async.Debug("debug")
+	async.Info("info")
+	async.WithField("hello", "world").Warnf("warn %d", 42)
+	async.Errorw("error", "answer", 42)
+
+	require.NoError(t, async.Flush(context.Background()))
+	require.Len(t, log.Entries, 3)
+	assert.Equal(t, []interface{}{"info"}, log.Entries[0].Args)
+	assert.Equal(t, map[string]interface{}{"hello": "world"}, log.Entries[1].Fields)
+	assert.Equal(t, map[string]interface{}{"answer": 42}, log.Entries[2].Fields)
+
+	require.NoError(t, async.Close(context.Background()))
+	require.NoError(t, async.Flush(context.Background()))
+
+	async.Info("after close")
+	require.Len(t, log.Entries, 4, "entries logged after close should be written synchronously")
+}
+
+func Test_Async_overflowPolicies(t *testing.T) {
+	tests := map[string]struct {
+		policy          OverflowPolicy
+		expectedArgs    []interface{}
+		expectedDropped uint64
+	}{
+		"drop newest": {
+			policy:          OverflowDropNewest,
+			expectedArgs:    []interface{}{1, 2},
+			expectedDropped: 1,
+		},
+		"drop oldest": {
+			policy:          OverflowDropOldest,
+			expectedArgs:    []interface{}{1, 3},
+			expectedDropped: 1,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			log := newBlockingLogger()
+			async := NewAsync(log, WithAsyncQueueSize(1), WithAsyncOverflowPolicy(test.policy))
+
+			async.Info(1)
+			<-log.started // the first entry is being written, the queue is empty
+			async.Info(2)
+			async.Info(3)
+			close(log.unblock)
+
+			require.NoError(t, async.Close(context.Background()))
+			assert.Equal(t, test.expectedArgs, entriesArgs(log.Entries))
+			assert.Equal(t, test.expectedDropped, async.Dropped())
+		})
+	}
+
+	t.Run("block", func(t *testing.T) {
+		t.Parallel()
+
+		log := newBlockingLogger()
+		async := NewAsync(log, WithAsyncQueueSize(1), WithAsyncOverflowPolicy(OverflowBlock))
+
+		async.Info(1)
+		<-log.started
+		async.Info(2)
+
+		logged := make(chan struct{})
+		go func() {
+			async.Info(3)
+			close(logged)
+		}()
+
+		select {
+		case <-logged:
+			t.Fatal("caller should be blocked as long as the queue is full")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		close(log.unblock)
+		<-logged
+
+		require.NoError(t, async.Close(context.Background()))
+		assert.Equal(t, []interface{}{1, 2, 3}, entriesArgs(log.Entries))
+		assert.Zero(t, async.Dropped())
+	})
+}
+
+func Test_Async_contextCanceled(t *testing.T) {
+	log := newBlockingLogger()
+	async := NewAsync(log)
+
+	async.Info(1)
+	<-log.started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, context.Canceled, async.Flush(ctx))
+	assert.Equal(t, context.Canceled, async.Close(ctx))
+
+	close(log.unblock)
+	require.NoError(t, async.Close(context.Background()))
+	assert.Equal(t, []interface{}{1}, entriesArgs(log.Entries))
+}
+
+func Test_Async_concurrency(t *testing.T) {
+	var (
+		log   = NewInMemory(LevelDebug)
+		async = NewAsync(log, WithAsyncQueueSize(8))
+		wg    sync.WaitGroup
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				async.Info(i)
+			}
+		}(i)
+	}
+
+	closed := make(chan error)
+	go func() {
+		wg.Wait()
+		closed <- async.Close(context.Background())
+	}()
+
+	require.NoError(t, <-closed)
+	assert.Len(t, log.Entries, 100)
+}
+
+func Test_Async_closeWhileBlocked(t *testing.T) {
+	log := newBlockingLogger()
+	async := NewAsync(log, WithAsyncQueueSize(1), WithAsyncOverflowPolicy(OverflowBlock))
+
+	async.Info(1)
+	<-log.started
+	async.Info(2)
+
+	logged := make(chan struct{})
+	go func() {
+		async.Info(3)
+		close(logged)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, async.Close(ctx), "close should not wait for a blocked caller")
+
+	close(log.unblock)
+	<-logged
+
+	require.NoError(t, async.Close(context.Background()))
+	assert.ElementsMatch(t, []interface{}{1, 2, 3}, entriesArgs(log.Entries))
+}
+
+func Test_WithAsyncQueueSize(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	async := NewAsync(log, WithAsyncQueueSize(-1))
+
+	async.Info(1)
+	async.Info(2)
+
+	require.NoError(t, async.Close(context.Background()))
+	assert.Equal(t, []interface{}{1, 2}, entriesArgs(log.Entries))
+}
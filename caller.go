@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// FieldStacktraceKey is the name of the field set by backends to report stack traces.
+const FieldStacktraceKey = "stacktrace"
+
+// callerSkippedPrefixes are the prefixes of the functions which are part of the
+// logging pipeline, and which are never reported as the caller of a log entry.
+var callerSkippedPrefixes = []string{
+	"github.com/krostar/logger.",
+	"github.com/krostar/logger/logrus.",
+	"github.com/krostar/logger/slog.",
+	"github.com/krostar/logger/zap.",
+	"github.com/sirupsen/logrus.",
+	"go.uber.org/zap.",
+	"go.uber.org/zap/",
+	"log.",
+	"log/slog.",
+	"runtime.",
+}
+
+// CallerFrame returns the frame of the function that logged the entry being
+// written, skipping the frames of this package, of the backends and of the
+// underlying logging libraries, whatever the number of wrappers is.
+// It is meant to be called by backends while writing an entry.
+// It returns false if no such frame is found, for instance when
+// the entry is written from another goroutine by NewAsync.
+func CallerFrame() (runtime.Frame, bool) {
+	var found runtime.Frame
+
+	ok := walkCallers(func(frame runtime.Frame) bool {
+		found = frame
+		return false
+	})
+
+	return found, ok
+}
+
+// Stacktrace returns the stack trace of the goroutine, starting at
+// the frame returned by CallerFrame, or an empty string if there is none.
+func Stacktrace() string {
+	var b strings.Builder
+
+	walkCallers(func(frame runtime.Frame) bool {
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(frame.Function)
+		b.WriteString("\n\t")
+		b.WriteString(frame.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(frame.Line))
+		return true
+	})
+
+	return b.String()
+}
+
+// walkCallers calls fct for each frame of the stack, starting at the first one
+// which is not part of the logging pipeline, until it returns false.
+// It returns true if fct was called at least once.
+func walkCallers(fct func(runtime.Frame) bool) bool {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs) // skip runtime.Callers, walkCallers and its caller
+
+	var (
+		frames = runtime.CallersFrames(pcs[:n])
+		called bool
+		inUser bool
+	)
+
+	for {
+		frame, more := frames.Next()
+
+		if inUser || !isLoggingFrame(frame) {
+			inUser, called = true, true
+			if !fct(frame) {
+				return true
+			}
+		}
+
+		if !more {
+			return called
+		}
+	}
+}
+
+func isLoggingFrame(frame runtime.Frame) bool {
+	if strings.HasSuffix(frame.File, "_test.go") {
+		return false
+	}
+
+	for _, prefix := range callerSkippedPrefixes {
+		if strings.HasPrefix(frame.Function, prefix) {
+			return true
+		}
+	}
+	return false
+}
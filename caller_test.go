@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CallerFrame(t *testing.T) {
+	frame, ok := CallerFrame()
+	require.True(t, ok)
+	assert.Equal(t, "github.com/krostar/logger.Test_CallerFrame", frame.Function)
+	assert.True(t, strings.HasSuffix(frame.File, "caller_test.go"))
+}
+
+func Test_Stacktrace(t *testing.T) {
+	stack := Stacktrace()
+	assert.True(t, strings.HasPrefix(stack, "github.com/krostar/logger.Test_Stacktrace\n\t"))
+	assert.Contains(t, stack, "testing.tRunner")
+}
+
+func Test_isLoggingFrame(t *testing.T) {
+	tests := map[string]struct {
+		frame    runtime.Frame
+		expected bool
+	}{
+		"root package": {
+			frame:    runtime.Frame{Function: "github.com/krostar/logger.(*wrapper).Info", File: "/src/logger/wrapper.go"},
+			expected: true,
+		}, "backend": {
+			frame:    runtime.Frame{Function: "github.com/krostar/logger/zap.(*Zap).Info", File: "/src/logger/zap/zap.go"},
+			expected: true,
+		}, "library": {
+			frame:    runtime.Frame{Function: "go.uber.org/zap/zapcore.(*CheckedEntry).Write", File: "/src/zapcore/entry.go"},
+			expected: true,
+		}, "standard logger": {
+			frame:    runtime.Frame{Function: "log.(*Logger).Output", File: "/go/src/log/log.go"},
+			expected: true,
+		}, "middleware": {
+			frame:    runtime.Frame{Function: "github.com/krostar/logger/logmid.New.func1.1", File: "/src/logger/logmid/logmid.go"},
+			expected: false,
+		}, "user": {
+			frame:    runtime.Frame{Function: "github.com/krostar/loggerx.Do", File: "/src/loggerx/do.go"},
+			expected: false,
+		}, "tests": {
+			frame:    runtime.Frame{Function: "github.com/krostar/logger.Test_isLoggingFrame", File: "/src/logger/caller_test.go"},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, isLoggingFrame(test.frame))
+		})
+	}
+}
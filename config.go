@@ -6,10 +6,15 @@ import (
 
 // Config defines all the configurable options for the logger.
 type Config struct {
-	Verbosity string `json:"verbosity"  yaml:"verbosity"`
-	Formatter string `json:"formatter"  yaml:"formatter"`
-	WithColor bool   `json:"with-color" yaml:"with-color"`
-	Output    string `json:"output"     yaml:"output"`
+	Verbosity string `json:"verbosity"   yaml:"verbosity"`
+	Formatter string `json:"formatter"   yaml:"formatter"`
+	WithColor bool   `json:"with-color"  yaml:"with-color"`
+	Output    string `json:"output"      yaml:"output"`
+	// WithCaller adds the file, line and function that logged each entry.
+	WithCaller bool `json:"with-caller" yaml:"with-caller"`
+	// Stacktrace is the minimum level of the entries logged with
+	// a stack trace, stack traces are disabled if empty.
+	Stacktrace string `json:"stacktrace"  yaml:"stacktrace"`
 }
 
 // SetDefault set sane default for logger's config.
@@ -26,6 +31,12 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("unable to parse level %q: %w", c.Verbosity, err)
 	}
 
+	if c.Stacktrace != "" {
+		if _, err := ParseLevel(c.Stacktrace); err != nil {
+			return fmt.Errorf("unable to parse stacktrace level %q: %w", c.Stacktrace, err)
+		}
+	}
+
 	switch c.Formatter {
 	case "json":
 	case "console":
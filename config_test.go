@@ -33,6 +33,17 @@ func TestConfig_Validate(t *testing.T) {
 		assert.Error(t, cfg.Validate())
 	})
 
+	t.Run("stacktrace fail", func(t *testing.T) {
+		var cfg Config
+		cfg.SetDefault()
+
+		cfg.Stacktrace = "boum"
+		assert.Error(t, cfg.Validate())
+
+		cfg.Stacktrace = "error"
+		assert.NoError(t, cfg.Validate())
+	})
+
 	t.Run("formatter fail", func(t *testing.T) {
 		var cfg Config
 		cfg.SetDefault()
@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"context"
+	"sync"
+)
+
+type ctxKey struct{}
+
+var defaultCtxLogger = struct {
+	sync.RWMutex
+	log Logger
+}{log: Noop{}}
+
+// SetContextDefault sets the logger returned by FromContext
+// when the context does not carry any logger.
+func SetContextDefault(l Logger) {
+	if l == nil {
+		l = Noop{}
+	}
+
+	defaultCtxLogger.Lock()
+	defaultCtxLogger.log = l
+	defaultCtxLogger.Unlock()
+}
+
+// WithContext returns a copy of ctx that carries the provided logger.
+func WithContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the logger stored in the context by WithContext,
+// or the default one (see SetContextDefault) if there is none.
+func FromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(ctxKey{}).(Logger); ok {
+		return l
+	}
+
+	defaultCtxLogger.RLock()
+	defer defaultCtxLogger.RUnlock()
+	return defaultCtxLogger.log
+}
+
+// WithFieldContext adds a field to the logger stored in the context
+// and returns a copy of ctx that carries the enriched logger.
+func WithFieldContext(ctx context.Context, key string, value interface{}) context.Context {
+	return WithContext(ctx, FromContext(ctx).WithField(key, value))
+}
+
+// WithFieldsContext adds multiple fields to the logger stored in the context
+// and returns a copy of ctx that carries the enriched logger.
+func WithFieldsContext(ctx context.Context, fields map[string]interface{}) context.Context {
+	return WithContext(ctx, FromContext(ctx).WithFields(fields))
+}
+
+// DebugContext logs a message at the 'debug' level using the logger stored in the context.
+func DebugContext(ctx context.Context, args ...interface{}) { FromContext(ctx).Debug(args...) }
+
+// DebugfContext is the same as DebugContext but with log formatting.
+func DebugfContext(ctx context.Context, format string, args ...interface{}) {
+	FromContext(ctx).Debugf(format, args...)
+}
+
+// InfoContext logs a message at the 'info' level using the logger stored in the context.
+func InfoContext(ctx context.Context, args ...interface{}) { FromContext(ctx).Info(args...) }
+
+// InfofContext is the same as InfoContext but with log formatting.
+func InfofContext(ctx context.Context, format string, args ...interface{}) {
+	FromContext(ctx).Infof(format, args...)
+}
+
+// WarnContext logs a message at the 'warn' level using the logger stored in the context.
+func WarnContext(ctx context.Context, args ...interface{}) { FromContext(ctx).Warn(args...) }
+
+// WarnfContext is the same as WarnContext but with log formatting.
+func WarnfContext(ctx context.Context, format string, args ...interface{}) {
+	FromContext(ctx).Warnf(format, args...)
+}
+
+// ErrorContext logs a message at the 'error' level using the logger stored in the context.
+func ErrorContext(ctx context.Context, args ...interface{}) { FromContext(ctx).Error(args...) }
+
+// ErrorfContext is the same as ErrorContext but with log formatting.
+func ErrorfContext(ctx context.Context, format string, args ...interface{}) {
+	FromContext(ctx).Errorf(format, args...)
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FromContext(t *testing.T) {
+	t.Run("without logger", func(t *testing.T) {
+		assert.Equal(t, Noop{}, FromContext(context.Background()))
+	})
+
+	t.Run("with logger", func(t *testing.T) {
+		log := NewInMemory(LevelDebug)
+		ctx := WithContext(context.Background(), log)
+		assert.Equal(t, log, FromContext(ctx))
+	})
+
+	t.Run("with custom default", func(t *testing.T) {
+		log := NewInMemory(LevelDebug)
+
+		SetContextDefault(log)
+		defer SetContextDefault(nil)
+
+		assert.Equal(t, log, FromContext(context.Background()))
+	})
+}
+
+func Test_WithFieldContext(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	ctx := WithContext(context.Background(), log)
+	ctx = WithFieldContext(ctx, "hello", "world")
+	ctx = WithFieldsContext(ctx, map[string]interface{}{"answer": 42})
+
+	InfoContext(ctx, "info")
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"hello":  "world",
+		"answer": 42,
+	}, log.Entries[0].Fields)
+}
+
+func Test_LogContext(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	ctx := WithContext(context.Background(), log)
+
+	tests := map[string]struct {
+		logFunc  func(ctx context.Context, args ...interface{})
+		logFFunc func(ctx context.Context, format string, args ...interface{})
+		level    Level
+	}{
+		"debug": {
+			logFunc:  DebugContext,
+			logFFunc: DebugfContext,
+			level:    LevelDebug,
+		}, "info": {
+			logFunc:  InfoContext,
+			logFFunc: InfofContext,
+			level:    LevelInfo,
+		}, "warn": {
+			logFunc:  WarnContext,
+			logFFunc: WarnfContext,
+			level:    LevelWarn,
+		}, "error": {
+			logFunc:  ErrorContext,
+			logFFunc: ErrorfContext,
+			level:    LevelError,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			// tests can't be ran in parallel as they share the same logger
+			log.Reset()
+
+			test.logFunc(ctx, "hello")
+			test.logFFunc(ctx, "hello %d", 42)
+
+			require.Len(t, log.Entries, 2)
+			assert.Equal(t, test.level, log.Entries[0].Level)
+			assert.Equal(t, []interface{}{"hello"}, log.Entries[0].Args)
+			assert.Equal(t, test.level, log.Entries[1].Level)
+			assert.Equal(t, "hello %d", log.Entries[1].Format)
+			assert.Equal(t, []interface{}{42}, log.Entries[1].Args)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Fields set by WithError, in addition to FieldErrorKey.
+const (
+	// FieldErrorTypeKey is the name of the field containing the type of the error.
+	FieldErrorTypeKey = "error_type"
+	// FieldErrorChainKey is the name of the field containing the messages of the
+	// errors wrapped by the error, as returned by errors.Unwrap or errors.Join.
+	FieldErrorChainKey = "error_chain"
+	// FieldErrorStackKey is the name of the field containing the verbose representation
+	// of the error, if any, which usually contains its stack trace.
+	FieldErrorStackKey = "error_stack"
+)
+
+// FieldsCarrier is implemented by errors that carry their own log fields,
+// like the identifiers of the entities involved, which are then added by
+// WithError without having to repeat them at every call site.
+type FieldsCarrier interface {
+	LogFields() map[string]interface{}
+}
+
+// ErrorFields returns the fields describing the provided error, as set by WithError:
+//   - FieldErrorKey contains the message of the error,
+//   - FieldErrorTypeKey contains its type,
+//   - FieldErrorChainKey, if the error wraps other errors, contains their
+//     messages in depth-first order,
+//   - FieldErrorStackKey, if the error or one of the errors it wraps formats
+//     differently with %+v, contains the first of these verbose representations,
+//     which usually includes a stack trace,
+//   - the fields of the error and of the errors it wraps that implement
+//     FieldsCarrier, sorted by key; on conflicts, the fields of outer errors
+//     take precedence over the ones of the errors they wrap, and carried
+//     fields named like one of the above are ignored.
+//
+// It returns nil if err is nil.
+func ErrorFields(err error) []Field {
+	if err == nil {
+		return nil
+	}
+
+	fields := []Field{
+		String(FieldErrorKey, err.Error()),
+		String(FieldErrorTypeKey, fmt.Sprintf("%T", err)),
+	}
+
+	wrapped := unwrapAll(err, nil)
+
+	if len(wrapped) > 0 {
+		chain := make([]string, len(wrapped))
+		for i, w := range wrapped {
+			chain[i] = w.Error()
+		}
+		fields = append(fields, Any(FieldErrorChainKey, chain))
+	}
+
+	for _, e := range append([]error{err}, wrapped...) {
+		if verbose := fmt.Sprintf("%+v", e); verbose != e.Error() {
+			fields = append(fields, String(FieldErrorStackKey, verbose))
+			break
+		}
+	}
+
+	return append(fields, carriedFields(append([]error{err}, wrapped...))...)
+}
+
+// carriedFields merges the fields carried by errs, the first errors taking
+// precedence, and returns them sorted by key.
+func carriedFields(errs []error) []Field {
+	merged := make(map[string]interface{})
+	for _, err := range errs {
+		carrier, ok := err.(FieldsCarrier)
+		if !ok {
+			continue
+		}
+		for key, value := range carrier.LogFields() {
+			if isErrorFieldKey(key) {
+				continue
+			}
+			if _, exists := merged[key]; !exists {
+				merged[key] = value
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]Field, len(keys))
+	for i, key := range keys {
+		fields[i] = Any(key, merged[key])
+	}
+	return fields
+}
+
+// ExpandErrorFields returns the fields with the ones built by Err replaced by the
+// fields describing their error, see ErrorFields, so that With(Err(err)) logs
+// the same fields as WithError(err). The provided slice is returned as is if
+// it does not contain any of them.
+func ExpandErrorFields(fields []Field) []Field {
+	var expanded []Field
+
+	for i, field := range fields {
+		err, ok := field.Interface.(error)
+		if !ok || field.Type != FieldTypeError || field.Key != FieldErrorKey {
+			if expanded != nil {
+				expanded = append(expanded, field)
+			}
+			continue
+		}
+		if expanded == nil {
+			expanded = append(make([]Field, 0, len(fields)+3), fields[:i]...)
+		}
+		expanded = append(expanded, ErrorFields(err)...)
+	}
+
+	if expanded == nil {
+		return fields
+	}
+	return expanded
+}
+
+// unwrapAll appends the errors wrapped by err to errs, in depth-first order.
+func unwrapAll(err error, errs []error) []error {
+	var wrapped []error
+
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		wrapped = e.Unwrap()
+	default:
+		if unwrapped := errors.Unwrap(err); unwrapped != nil {
+			wrapped = []error{unwrapped}
+		}
+	}
+
+	for _, w := range wrapped {
+		if w != nil {
+			errs = unwrapAll(w, append(errs, w))
+		}
+	}
+
+	return errs
+}
+
+// isErrorFieldKey returns whether key is the name of a field describing the error.
+func isErrorFieldKey(key string) bool {
+	switch key {
+	case FieldErrorKey, FieldErrorTypeKey, FieldErrorChainKey, FieldErrorStackKey:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stackError formats with its stack trace, like github.com/pkg/errors errors.
+type stackError struct{ msg string }
+
+func (e stackError) Error() string { return e.msg }
+
+func (e stackError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		_, _ = fmt.Fprintf(s, "%s\nmain.main\n\t/src/main.go:42", e.msg)
+		return
+	}
+	_, _ = fmt.Fprint(s, e.msg)
+}
+
+// fieldsError carries its own log fields.
+type fieldsError struct {
+	err    error
+	fields map[string]interface{}
+}
+
+func (e fieldsError) Error() string                     { return e.err.Error() }
+func (e fieldsError) Unwrap() error                     { return e.err }
+func (e fieldsError) LogFields() map[string]interface{} { return e.fields }
+
+func Test_ErrorFields(t *testing.T) {
+	tests := map[string]struct {
+		err            error
+		expectedFields map[string]interface{}
+	}{
+		"nil": {
+			expectedFields: map[string]interface{}{},
+		}, "simple": {
+			err: errors.New("eww"),
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:     "eww",
+				FieldErrorTypeKey: "*errors.errorString",
+			},
+		}, "wrapped": {
+			err: fmt.Errorf("unable to do: %w", fmt.Errorf("unable to read: %w", errors.New("eww"))),
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:      "unable to do: unable to read: eww",
+				FieldErrorTypeKey:  "*fmt.wrapError",
+				FieldErrorChainKey: []string{"unable to read: eww", "eww"},
+			},
+		}, "joined": {
+			err: errors.Join(errors.New("eww1"), fmt.Errorf("eww2: %w", errors.New("eww3"))),
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:      "eww1\neww2: eww3",
+				FieldErrorTypeKey:  "*errors.joinError",
+				FieldErrorChainKey: []string{"eww1", "eww2: eww3", "eww3"},
+			},
+		}, "stack carried by a wrapped error": {
+			err: fmt.Errorf("unable to do: %w", stackError{msg: "eww"}),
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:      "unable to do: eww",
+				FieldErrorTypeKey:  "*fmt.wrapError",
+				FieldErrorChainKey: []string{"eww"},
+				FieldErrorStackKey: "eww\nmain.main\n\t/src/main.go:42",
+			},
+		}, "fields carried by the error chain": {
+			err: fmt.Errorf("unable to order: %w", fieldsError{
+				err: fieldsError{
+					err:    errors.New("eww"),
+					fields: map[string]interface{}{"user_id": 1, "retries": 3},
+				},
+				fields: map[string]interface{}{"order_id": "o-42", "retries": 5},
+			}),
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:      "unable to order: eww",
+				FieldErrorTypeKey:  "*fmt.wrapError",
+				FieldErrorChainKey: []string{"eww", "eww", "eww"},
+				"user_id":          1,
+				"order_id":         "o-42",
+				"retries":          5,
+			},
+		}, "carried fields cannot override the error description": {
+			err: fieldsError{
+				err: errors.New("eww"),
+				fields: map[string]interface{}{
+					FieldErrorKey:      "fake",
+					FieldErrorTypeKey:  "fake",
+					FieldErrorChainKey: "fake",
+					FieldErrorStackKey: "fake",
+					"user_id":          1,
+				},
+			},
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:      "eww",
+				FieldErrorTypeKey:  "logger.fieldsError",
+				FieldErrorChainKey: []string{"eww"},
+				"user_id":          1,
+			},
+		}, "stack carried by the error": {
+			err: stackError{msg: "eww"},
+			expectedFields: map[string]interface{}{
+				FieldErrorKey:      "eww",
+				FieldErrorTypeKey:  "logger.stackError",
+				FieldErrorStackKey: "eww\nmain.main\n\t/src/main.go:42",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expectedFields, FieldsToMap(ErrorFields(test.err)))
+		})
+	}
+}
+
+func Test_ExpandErrorFields(t *testing.T) {
+	t.Run("without errors", func(t *testing.T) {
+		fields := []Field{String("hello", "world"), Err(nil)}
+		expanded := ExpandErrorFields(fields)
+
+		assert.Equal(t, fields, expanded)
+		assert.Equal(t, &fields[0], &expanded[0], "slice should not be copied")
+	})
+
+	t.Run("with errors", func(t *testing.T) {
+		err := fmt.Errorf("wrap: %w", errors.New("eww"))
+		expanded := ExpandErrorFields([]Field{String("hello", "world"), Err(err), Int("answer", 42)})
+
+		assert.Equal(t, append(append([]Field{String("hello", "world")}, ErrorFields(err)...), Int("answer", 42)), expanded)
+	})
+}
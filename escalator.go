@@ -0,0 +1,182 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EscalatorOption defines a function signature to update the escalator configuration.
+type EscalatorOption func(*escalator)
+
+// WithEscalationWindow sets how long the level stays escalated
+// after the last triggering entry. Default is 1m.
+func WithEscalationWindow(window time.Duration) EscalatorOption {
+	return func(e *escalator) {
+		e.window = window
+	}
+}
+
+// WithEscalationLevel sets the level applied during the escalation. Default is LevelDebug.
+func WithEscalationLevel(lvl Level) EscalatorOption {
+	return func(e *escalator) {
+		e.level = lvl
+	}
+}
+
+// WithEscalationTrigger sets the minimum level of the entries
+// that trigger the escalation. Default is LevelError.
+func WithEscalationTrigger(lvl Level) EscalatorOption {
+	return func(e *escalator) {
+		e.trigger = lvl
+	}
+}
+
+// NewEscalator returns a logger that lowers the level of the provided logger
+// when an entry at the 'error' level is logged, to get detailed logs around
+// incidents. The configured level is restored once no error has been logged
+// for the escalation window, even if nothing is logged anymore.
+//
+// Levels set with SetLevel during the escalation are applied once it ends.
+func NewEscalator(l Logger, opts ...EscalatorOption) Logger {
+	e := newEscalator(l, opts...)
+	return e.wrap(l)
+}
+
+type escalator struct {
+	window  time.Duration
+	level   Level
+	trigger Level
+	now     func() time.Time
+
+	backend    Logger
+	escalated  atomic.Bool
+	lock       sync.Mutex
+	configured Level
+	until      time.Time
+	timer      *time.Timer
+}
+
+func newEscalator(l Logger, opts ...EscalatorOption) *escalator {
+	e := &escalator{
+		window:  time.Minute,
+		level:   LevelDebug,
+		trigger: LevelError,
+		now:     time.Now,
+		backend: l,
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+func (e *escalator) wrap(l Logger) Logger {
+	n := &escalatorNode{escalator: e}
+	n.wrapper = &wrapper{Logger: l, handle: e.handle, wrap: e.wrap}
+	return n
+}
+
+func (e *escalator) handle(next Logger, en entry) {
+	e.restoreIfExpired()
+
+	en.writeTo(next)
+
+	if en.level >= e.trigger && en.level < LevelQuiet {
+		e.escalate()
+	}
+}
+
+// escalate lowers the level of the underlying logger, or extends
+// the escalation window if the level is already escalated.
+func (e *escalator) escalate() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.until = e.now().Add(e.window)
+	if e.escalated.Load() {
+		e.scheduleRestore()
+		return
+	}
+
+	configured := e.backend.Level()
+	if configured <= e.level {
+		return
+	}
+	if err := e.backend.SetLevel(e.level); err != nil {
+		return
+	}
+
+	e.configured = configured
+	e.escalated.Store(true)
+	e.scheduleRestore()
+}
+
+// scheduleRestore restores the configured level at the end of the window,
+// as the escalator may not be called again to do it.
+func (e *escalator) scheduleRestore() {
+	if e.timer == nil {
+		e.timer = time.AfterFunc(e.window, e.restoreIfExpired)
+		return
+	}
+	e.timer.Reset(e.window)
+}
+
+// restoreIfExpired restores the configured level once the escalation window is over.
+func (e *escalator) restoreIfExpired() {
+	if !e.escalated.Load() {
+		return
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if !e.escalated.Load() || e.now().Before(e.until) {
+		return
+	}
+
+	if err := e.backend.SetLevel(e.configured); err == nil {
+		e.escalated.Store(false)
+	}
+}
+
+func (e *escalator) setLevel(lvl Level) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.escalated.Load() && lvl > e.level {
+		e.configured = lvl
+		return nil
+	}
+
+	if err := e.backend.SetLevel(lvl); err != nil {
+		return err
+	}
+	e.escalated.Store(false)
+	return nil
+}
+
+// escalatorNode is a logger derived from the escalator.
+type escalatorNode struct {
+	*wrapper
+	escalator *escalator
+}
+
+// SetLevel implements Logger for escalatorNode.
+func (n *escalatorNode) SetLevel(lvl Level) error {
+	return n.escalator.setLevel(lvl)
+}
+
+// Level implements Logger for escalatorNode.
+func (n *escalatorNode) Level() Level {
+	n.escalator.restoreIfExpired()
+	return n.wrapper.Level()
+}
+
+// Enabled implements Logger for escalatorNode.
+func (n *escalatorNode) Enabled(lvl Level) bool {
+	n.escalator.restoreIfExpired()
+	return n.wrapper.Enabled(lvl)
+}
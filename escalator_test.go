@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewEscalator(t *testing.T) {
+	log := NewInMemory(LevelInfo)
+	escalated := NewEscalator(log)
+
+	escalated.Debug("before")
+	escalated.WithField("a", 1).Error("error")
+	escalated.Debug("after")
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, []interface{}{"error"}, log.Entries[0].Args)
+	assert.Equal(t, []interface{}{"after"}, log.Entries[1].Args)
+	assert.Equal(t, LevelDebug, escalated.Level())
+}
+
+// levelNotifier sends each level change on a channel.
+type levelNotifier struct {
+	*InMemory
+	changes chan Level
+}
+
+func (n *levelNotifier) SetLevel(lvl Level) error {
+	err := n.InMemory.SetLevel(lvl)
+	n.changes <- lvl
+	return err
+}
+
+func newLevelNotifier(lvl Level) *levelNotifier {
+	return &levelNotifier{InMemory: NewInMemory(lvl), changes: make(chan Level, 10)}
+}
+
+func waitLevel(t *testing.T, changes chan Level) Level {
+	select {
+	case lvl := <-changes:
+		return lvl
+	case <-time.After(time.Second):
+		t.Fatal("level was not changed in time")
+		return LevelQuiet
+	}
+}
+
+func Test_NewEscalator_restoredWithoutEntries(t *testing.T) {
+	log := newLevelNotifier(LevelInfo)
+	escalated := NewEscalator(log, WithEscalationWindow(10*time.Millisecond))
+
+	escalated.Error("error")
+	assert.Equal(t, LevelDebug, waitLevel(t, log.changes))
+	assert.Equal(t, LevelInfo, waitLevel(t, log.changes), "level should be restored at the end of the window")
+}
+
+func Test_escalator(t *testing.T) {
+	var (
+		log = NewInMemory(LevelWarn)
+		now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	e := newEscalator(log,
+		WithEscalationWindow(time.Minute),
+		WithEscalationLevel(LevelInfo),
+		WithEscalationTrigger(LevelWarn),
+	)
+	e.now = func() time.Time { return now }
+	escalated := e.wrap(log)
+
+	escalated.Info("dropped")
+	assert.True(t, escalated.Enabled(LevelWarn))
+	assert.False(t, escalated.Enabled(LevelInfo))
+
+	escalated.Warn("trigger")
+	assert.Equal(t, LevelInfo, log.Level())
+	escalated.Debug("dropped")
+	escalated.Info("kept")
+
+	now = now.Add(50 * time.Second)
+	escalated.Warn("extend")
+
+	now = now.Add(50 * time.Second)
+	assert.True(t, escalated.Enabled(LevelInfo), "window should have been extended by the last warning")
+
+	require.NoError(t, escalated.SetLevel(LevelError))
+	assert.Equal(t, LevelInfo, log.Level(), "level should be applied once the escalation is over")
+
+	now = now.Add(time.Minute)
+	assert.False(t, escalated.Enabled(LevelWarn))
+	assert.Equal(t, LevelError, escalated.Level())
+
+	require.Len(t, log.Entries, 3)
+	for i, expected := range []string{"trigger", "kept", "extend"} {
+		assert.Equal(t, []interface{}{expected}, log.Entries[i].Args)
+	}
+
+	escalated.Error("trigger")
+	require.NoError(t, escalated.SetLevel(LevelDebug))
+	now = now.Add(time.Hour)
+	assert.Equal(t, LevelDebug, escalated.Level(), "level lower than the escalation one should end the escalation")
+}
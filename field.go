@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"math"
+	"time"
+)
+
+// FieldType indicates which member of a Field holds its value.
+type FieldType uint8
+
+const (
+	// FieldTypeSkip fields are ignored by all loggers.
+	FieldTypeSkip FieldType = iota
+	// FieldTypeAny fields store their value as is in Interface.
+	FieldTypeAny
+	// FieldTypeString fields store their value in String.
+	FieldTypeString
+	// FieldTypeInt64 fields store their value in Integer.
+	FieldTypeInt64
+	// FieldTypeFloat64 fields store the bits of their value in Integer.
+	FieldTypeFloat64
+	// FieldTypeBool fields store 1 for true and 0 for false in Integer.
+	FieldTypeBool
+	// FieldTypeDuration fields store their value in Integer.
+	FieldTypeDuration
+	// FieldTypeTime fields store their value as unix nanoseconds in Integer
+	// and the location in Interface.
+	FieldTypeTime
+	// FieldTypeError fields store the error in Interface.
+	FieldTypeError
+)
+
+// Field is a strongly typed key-value pair that can be added to the logging context
+// without being boxed into an interface when the underlying logger supports it.
+type Field struct {
+	Key       string
+	Type      FieldType
+	Integer   int64
+	String    string
+	Interface interface{}
+}
+
+// String constructs a field that carries a string.
+func String(key string, value string) Field {
+	return Field{Key: key, Type: FieldTypeString, String: value}
+}
+
+// Int constructs a field that carries an int.
+func Int(key string, value int) Field {
+	return Int64(key, int64(value))
+}
+
+// Int64 constructs a field that carries an int64.
+func Int64(key string, value int64) Field {
+	return Field{Key: key, Type: FieldTypeInt64, Integer: value}
+}
+
+// Float64 constructs a field that carries a float64.
+func Float64(key string, value float64) Field {
+	return Field{Key: key, Type: FieldTypeFloat64, Integer: int64(math.Float64bits(value))}
+}
+
+// Bool constructs a field that carries a bool.
+func Bool(key string, value bool) Field {
+	var i int64
+	if value {
+		i = 1
+	}
+	return Field{Key: key, Type: FieldTypeBool, Integer: i}
+}
+
+// Duration constructs a field that carries a time.Duration.
+func Duration(key string, value time.Duration) Field {
+	return Field{Key: key, Type: FieldTypeDuration, Integer: int64(value)}
+}
+
+// Time constructs a field that carries a time.Time.
+func Time(key string, value time.Time) Field {
+	return Field{Key: key, Type: FieldTypeTime, Integer: value.UnixNano(), Interface: value.Location()}
+}
+
+// Err constructs a field that carries an error, using FieldErrorKey as key.
+// Loggers expand it into the same fields as WithError, see ExpandErrorFields.
+// A nil error produces a field that is ignored.
+func Err(err error) Field {
+	if err == nil {
+		return Field{Type: FieldTypeSkip}
+	}
+	return Field{Key: FieldErrorKey, Type: FieldTypeError, Interface: err}
+}
+
+// Any constructs a field that carries any value.
+func Any(key string, value interface{}) Field {
+	return Field{Key: key, Type: FieldTypeAny, Interface: value}
+}
+
+// Value returns the value carried by the field.
+func (f Field) Value() interface{} {
+	switch f.Type {
+	case FieldTypeString:
+		return f.String
+	case FieldTypeInt64:
+		return f.Integer
+	case FieldTypeFloat64:
+		return math.Float64frombits(uint64(f.Integer))
+	case FieldTypeBool:
+		return f.Integer == 1
+	case FieldTypeDuration:
+		return time.Duration(f.Integer)
+	case FieldTypeTime:
+		t := time.Unix(0, f.Integer)
+		if loc, ok := f.Interface.(*time.Location); ok {
+			t = t.In(loc)
+		}
+		return t
+	case FieldTypeSkip:
+		return nil
+	default:
+		return f.Interface
+	}
+}
+
+// FieldsToMap converts strongly typed fields to a map of fields.
+func FieldsToMap(fields []Field) map[string]interface{} {
+	m := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if field.Type != FieldTypeSkip {
+			m[field.Key] = field.Value()
+		}
+	}
+	return m
+}
@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Field_Value(t *testing.T) {
+	var (
+		err = errors.New("eww")
+		now = time.Date(2020, 1, 2, 3, 4, 5, 6, time.FixedZone("somewhere", 3600))
+	)
+
+	tests := map[string]struct {
+		field         Field
+		expectedType  FieldType
+		expectedValue interface{}
+	}{
+		"string": {
+			field:         String("key", "value"),
+			expectedType:  FieldTypeString,
+			expectedValue: "value",
+		}, "int": {
+			field:         Int("key", 42),
+			expectedType:  FieldTypeInt64,
+			expectedValue: int64(42),
+		}, "int64": {
+			field:         Int64("key", -42),
+			expectedType:  FieldTypeInt64,
+			expectedValue: int64(-42),
+		}, "float64": {
+			field:         Float64("key", 4.2),
+			expectedType:  FieldTypeFloat64,
+			expectedValue: 4.2,
+		}, "bool true": {
+			field:         Bool("key", true),
+			expectedType:  FieldTypeBool,
+			expectedValue: true,
+		}, "bool false": {
+			field:         Bool("key", false),
+			expectedType:  FieldTypeBool,
+			expectedValue: false,
+		}, "duration": {
+			field:         Duration("key", time.Second),
+			expectedType:  FieldTypeDuration,
+			expectedValue: time.Second,
+		}, "time": {
+			field:         Time("key", now),
+			expectedType:  FieldTypeTime,
+			expectedValue: now,
+		}, "any": {
+			field:         Any("key", []int{4, 2}),
+			expectedType:  FieldTypeAny,
+			expectedValue: []int{4, 2},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, "key", test.field.Key)
+			assert.Equal(t, test.expectedType, test.field.Type)
+			assert.Equal(t, test.expectedValue, test.field.Value())
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		field := Err(err)
+		assert.Equal(t, FieldErrorKey, field.Key)
+		assert.Equal(t, FieldTypeError, field.Type)
+		assert.Equal(t, err, field.Value())
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		field := Err(nil)
+		assert.Equal(t, FieldTypeSkip, field.Type)
+		assert.Nil(t, field.Value())
+	})
+}
+
+func Test_FieldsToMap(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{
+		"hello":  "world",
+		"answer": int64(42),
+	}, FieldsToMap([]Field{String("hello", "world"), Int("answer", 42), Err(nil)}))
+}
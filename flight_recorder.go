@@ -0,0 +1,193 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// FlightRecorderOption defines a function signature to update the flight recorder configuration.
+type FlightRecorderOption func(*FlightRecorder)
+
+// WithFlightRecorderSize sets the maximum number of entries
+// recorded by each derived logger. Default is 100.
+func WithFlightRecorderSize(size int) FlightRecorderOption {
+	return func(r *FlightRecorder) {
+		r.size = size
+	}
+}
+
+// WithFlightRecorderTrigger sets the minimum level of the entries
+// that dump the recorded ones. Default is LevelError.
+func WithFlightRecorderTrigger(lvl Level) FlightRecorderOption {
+	return func(r *FlightRecorder) {
+		r.trigger = lvl
+	}
+}
+
+// FlightRecorder is a logger that records the last entries that are
+// below its level instead of dropping them, and writes them when an entry
+// at the 'error' level is logged, or when Dump is called.
+//
+// Each logger derived with Named or one of the With methods records its
+// own entries. When dumped, the entries of the logger and of the loggers
+// it derives from are written in the order they were logged.
+//
+// The flight recorder handles the level by itself, the underlying
+// logger is set to the 'debug' level to be able to write any entry.
+type FlightRecorder struct {
+	*flightRecorderNode
+
+	size    int
+	trigger Level
+	level   atomic.Int32
+	seq     atomic.Uint64
+}
+
+// NewFlightRecorder returns a flight recorder on top of the provided logger,
+// which level becomes the level of the flight recorder.
+func NewFlightRecorder(l Logger, opts ...FlightRecorderOption) (*FlightRecorder, error) {
+	r := &FlightRecorder{
+		size:    100,
+		trigger: LevelError,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.level.Store(int32(l.Level()))
+	if err := l.SetLevel(LevelDebug); err != nil {
+		return nil, fmt.Errorf("unable to set underlying logger level: %w", err)
+	}
+
+	r.flightRecorderNode = r.newNode(nil, l)
+	return r, nil
+}
+
+// DumpFlightRecord writes the entries recorded by the provided
+// logger, if it is derived from a flight recorder.
+func DumpFlightRecord(l Logger) {
+	if n, ok := l.(interface{ Dump() }); ok {
+		n.Dump()
+	}
+}
+
+func (r *FlightRecorder) newNode(parent *flightRecorderNode, l Logger) *flightRecorderNode {
+	n := &flightRecorderNode{recorder: r, parent: parent}
+	n.wrapper = &wrapper{
+		Logger: l,
+		handle: n.handle,
+		wrap:   func(l Logger) Logger { return r.newNode(n, l) },
+	}
+	return n
+}
+
+type flightRecord struct {
+	seq   uint64
+	next  Logger
+	entry entry
+}
+
+// flightRecorderNode is a logger derived from the flight recorder.
+type flightRecorderNode struct {
+	*wrapper
+	recorder *FlightRecorder
+	parent   *flightRecorderNode
+
+	lock    sync.Mutex
+	records []flightRecord // ring buffer allocated on first record
+	oldest  int
+	count   int
+}
+
+// SetLevel implements Logger for flightRecorderNode,
+// it sets the level of the whole flight recorder.
+func (n *flightRecorderNode) SetLevel(lvl Level) error {
+	if lvl < LevelDebug || lvl > LevelQuiet {
+		return fmt.Errorf("invalid level %s", lvl)
+	}
+	n.recorder.level.Store(int32(lvl))
+	return nil
+}
+
+// Level implements Logger for flightRecorderNode.
+func (n *flightRecorderNode) Level() Level {
+	return Level(n.recorder.level.Load())
+}
+
+// Enabled implements Logger for flightRecorderNode. It returns
+// true if entries at the provided level are directly written.
+func (n *flightRecorderNode) Enabled(lvl Level) bool {
+	return lvl >= n.Level() && lvl < LevelQuiet && n.wrapper.Logger.Enabled(lvl)
+}
+
+// Dump writes and forgets the recorded entries.
+func (n *flightRecorderNode) Dump() {
+	var records []flightRecord
+	for node := n; node != nil; node = node.parent {
+		records = append(records, node.flush()...)
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].seq < records[j].seq })
+
+	for _, record := range records {
+		record.entry.writeTo(record.next)
+	}
+}
+
+func (n *flightRecorderNode) handle(next Logger, e entry) {
+	if e.level < LevelDebug || e.level >= LevelQuiet {
+		return
+	}
+
+	if e.level < n.Level() {
+		n.record(flightRecord{seq: n.recorder.seq.Add(1), next: next, entry: e})
+		return
+	}
+
+	if e.level >= n.recorder.trigger {
+		n.Dump()
+	}
+	e.writeTo(next)
+}
+
+func (n *flightRecorderNode) record(record flightRecord) {
+	if n.recorder.size <= 0 {
+		return
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.records == nil {
+		n.records = make([]flightRecord, n.recorder.size)
+	}
+
+	if n.count < len(n.records) {
+		n.records[(n.oldest+n.count)%len(n.records)] = record
+		n.count++
+		return
+	}
+
+	// the buffer is full, overwrite the oldest record
+	n.records[n.oldest] = record
+	n.oldest = (n.oldest + 1) % len(n.records)
+}
+
+// flush returns the recorded entries and empties the buffer.
+func (n *flightRecorderNode) flush() []flightRecord {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	records := make([]flightRecord, 0, n.count)
+	for i := 0; i < n.count; i++ {
+		idx := (n.oldest + i) % len(n.records)
+		records = append(records, n.records[idx])
+		n.records[idx] = flightRecord{}
+	}
+	n.oldest, n.count = 0, 0
+
+	return records
+}
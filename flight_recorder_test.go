@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewFlightRecorder(t *testing.T) {
+	log := NewInMemory(LevelInfo)
+
+	recorder, err := NewFlightRecorder(log, WithFlightRecorderSize(2))
+	require.NoError(t, err)
+	assert.Equal(t, LevelDebug, log.Level())
+	assert.Equal(t, LevelInfo, recorder.Level())
+	assert.False(t, recorder.Enabled(LevelDebug))
+	assert.True(t, recorder.Enabled(LevelInfo))
+
+	request := recorder.WithField("request", 1)
+	other := recorder.WithField("request", 2)
+
+	recorder.Debug("root 1")
+	request.Debug("request 1")
+	other.Debug("other 1")
+	recorder.Debug("root 2")
+	recorder.Debug("root 3") // evicts "root 1"
+	request.Debugf("request %d", 2)
+	request.Info("info")
+	request.WithError(errors.New("eww")).Error("error")
+
+	require.Len(t, log.Entries, 6)
+	for i, expected := range []struct {
+		format string
+		args   []interface{}
+		fields map[string]interface{}
+	}{
+		{args: []interface{}{"info"}, fields: map[string]interface{}{"request": 1}},
+		{args: []interface{}{"request 1"}, fields: map[string]interface{}{"request": 1}},
+		{args: []interface{}{"root 2"}, fields: map[string]interface{}{}},
+		{args: []interface{}{"root 3"}, fields: map[string]interface{}{}},
+		{format: "request %d", args: []interface{}{2}, fields: map[string]interface{}{"request": 1}},
+		{args: []interface{}{"error"}, fields: map[string]interface{}{"request": 1, FieldErrorKey: "eww", FieldErrorTypeKey: "*errors.errorString"}},
+	} {
+		assert.Equal(t, expected.format, log.Entries[i].Format)
+		assert.Equal(t, expected.args, log.Entries[i].Args)
+		assert.Equal(t, expected.fields, log.Entries[i].Fields)
+	}
+
+	log.Reset()
+	recorder.Dump()
+	require.Len(t, log.Entries, 0, "dumped entries should be forgotten")
+
+	DumpFlightRecord(other)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, []interface{}{"other 1"}, log.Entries[0].Args)
+}
+
+func TestFlightRecorder_SetLevel(t *testing.T) {
+	log := NewInMemory(LevelInfo)
+
+	recorder, err := NewFlightRecorder(log, WithFlightRecorderTrigger(LevelWarn))
+	require.NoError(t, err)
+
+	require.Error(t, recorder.SetLevel(Level(42)))
+	require.NoError(t, recorder.Named("child").SetLevel(LevelWarn))
+	assert.Equal(t, LevelWarn, recorder.Level())
+	assert.Equal(t, LevelDebug, log.Level())
+
+	recorder.Info("recorded")
+	recorder.Warn("trigger")
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, []interface{}{"recorded"}, log.Entries[0].Args)
+	assert.Equal(t, []interface{}{"trigger"}, log.Entries[1].Args)
+}
+
+func Test_NewFlightRecorder_failure(t *testing.T) {
+	_, err := NewFlightRecorder(&levelErrorLogger{Logger: NewInMemory(LevelInfo)})
+	require.Error(t, err)
+}
+
+func Test_DumpFlightRecord(t *testing.T) {
+	DumpFlightRecord(Noop{}) // does not panic
+}
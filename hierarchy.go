@@ -0,0 +1,166 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Hierarchy is a logger whose named children, created with Named, can each
+// have their own level. A child without a configured level inherits the level
+// of its nearest configured parent: "db.pool" inherits from "db", which
+// inherits from the root logger.
+//
+// The underlying logger level is kept to the lowest configured level,
+// entries are then filtered by the hierarchy according to the logger name.
+type Hierarchy struct {
+	Logger
+
+	backend Logger
+	lock    sync.RWMutex
+	levels  map[string]Level
+}
+
+// NewHierarchy returns a logger that handles levels per logger name.
+// The root level is initialized with the level of the provided logger.
+func NewHierarchy(l Logger) *Hierarchy {
+	h := &Hierarchy{
+		backend: l,
+		levels:  map[string]Level{"": l.Level()},
+	}
+	h.Logger = h.wrap("")(l)
+	return h
+}
+
+// ModuleLevel returns the level applied to the logger named name,
+// which is either configured or inherited from its parents.
+// The empty name designates the root logger.
+func (h *Hierarchy) ModuleLevel(name string) Level {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	for {
+		if lvl, ok := h.levels[name]; ok {
+			return lvl
+		}
+
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return h.levels[""]
+		}
+		name = name[:i]
+	}
+}
+
+// ModuleLevels returns all the configured levels indexed by logger name.
+// The empty name designates the root logger.
+func (h *Hierarchy) ModuleLevels() map[string]Level {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	levels := make(map[string]Level, len(h.levels))
+	for name, lvl := range h.levels {
+		levels[name] = lvl
+	}
+	return levels
+}
+
+// SetModuleLevel configures the level of the logger named name, and of
+// all its children that do not have their own level.
+// The empty name designates the root logger.
+func (h *Hierarchy) SetModuleLevel(name string, lvl Level) error {
+	if lvl < LevelDebug || lvl > LevelQuiet {
+		return fmt.Errorf("invalid level %s", lvl)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.update(name, func(levels map[string]Level) { levels[name] = lvl })
+}
+
+// UnsetModuleLevel removes the level configured for the logger named name,
+// which inherits again the level of its parents.
+// The root logger level cannot be unset.
+func (h *Hierarchy) UnsetModuleLevel(name string) error {
+	if name == "" {
+		return fmt.Errorf("root logger level cannot be unset")
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.update(name, func(levels map[string]Level) { delete(levels, name) })
+}
+
+// update applies fct to the levels, and sets the underlying logger level
+// to the lowest of them. Levels are left untouched if the latter fails.
+func (h *Hierarchy) update(name string, fct func(map[string]Level)) error {
+	levels := make(map[string]Level, len(h.levels)+1)
+	for n, lvl := range h.levels {
+		levels[n] = lvl
+	}
+	fct(levels)
+
+	lowest := LevelQuiet
+	for _, lvl := range levels {
+		if lvl < lowest {
+			lowest = lvl
+		}
+	}
+
+	if lowest < LevelQuiet && lowest != h.backend.Level() {
+		if err := h.backend.SetLevel(lowest); err != nil {
+			return fmt.Errorf("unable to set level of logger %q: %w", name, err)
+		}
+	}
+
+	h.levels = levels
+	return nil
+}
+
+func (h *Hierarchy) wrap(name string) func(Logger) Logger {
+	var wrap func(Logger) Logger
+	wrap = func(l Logger) Logger {
+		n := &hierarchyNode{hierarchy: h, name: name}
+		n.wrapper = &wrapper{Logger: l, handle: n.handle, wrap: wrap}
+		return n
+	}
+	return wrap
+}
+
+// hierarchyNode is a logger of the hierarchy, bound to a name.
+type hierarchyNode struct {
+	*wrapper
+	hierarchy *Hierarchy
+	name      string
+}
+
+// SetLevel implements Logger for hierarchyNode, it only
+// applies to this logger name and to its children.
+func (n *hierarchyNode) SetLevel(lvl Level) error {
+	return n.hierarchy.SetModuleLevel(n.name, lvl)
+}
+
+// Level implements Logger for hierarchyNode.
+func (n *hierarchyNode) Level() Level {
+	return n.hierarchy.ModuleLevel(n.name)
+}
+
+// Enabled implements Logger for hierarchyNode. The level of the
+// underlying logger is the one the hierarchy manages, not the one
+// of the derived logger which may have been copied on creation.
+func (n *hierarchyNode) Enabled(lvl Level) bool {
+	return lvl >= n.Level() && lvl < LevelQuiet && n.hierarchy.backend.Enabled(lvl)
+}
+
+// Named implements Logger for hierarchyNode.
+func (n *hierarchyNode) Named(name string) Logger {
+	return n.hierarchy.wrap(JoinNames(n.name, name))(n.wrapper.Logger.Named(name))
+}
+
+func (n *hierarchyNode) handle(next Logger, e entry) {
+	if n.Enabled(e.level) {
+		e.writeTo(next)
+	}
+}
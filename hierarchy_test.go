@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewHierarchy(t *testing.T) {
+	var (
+		backend = NewInMemory(LevelInfo)
+		log     = NewHierarchy(backend)
+		db      = log.Named("db")
+		pool    = db.Named("pool")
+		http    = log.Named("http")
+	)
+
+	assert.Equal(t, LevelInfo, log.Level())
+	assert.Equal(t, LevelInfo, pool.Level())
+
+	require.NoError(t, db.WithField("hello", "world").SetLevel(LevelDebug))
+	assert.Equal(t, LevelDebug, backend.Level(), "backend should be set to the lowest level")
+	assert.Equal(t, LevelInfo, log.Level())
+	assert.Equal(t, LevelDebug, db.Level())
+	assert.Equal(t, LevelDebug, pool.Level(), "pool should inherit db level")
+	assert.Equal(t, LevelInfo, http.Level())
+
+	require.NoError(t, pool.SetLevel(LevelError))
+	assert.Equal(t, LevelDebug, db.Level())
+	assert.Equal(t, LevelError, pool.Level())
+
+	log.Debug("root")
+	db.Debug("db")
+	pool.Warn("pool")
+	pool.Error("pool")
+	http.Debug("http")
+	http.Infof("http %d", 42)
+
+	require.Len(t, backend.Entries, 3)
+	assert.Equal(t, []interface{}{"db"}, backend.Entries[0].Args)
+	assert.Equal(t, map[string]interface{}{FieldNameKey: "db"}, backend.Entries[0].Fields)
+	assert.Equal(t, []interface{}{"pool"}, backend.Entries[1].Args)
+	assert.Equal(t, map[string]interface{}{FieldNameKey: "db.pool"}, backend.Entries[1].Fields)
+	assert.Equal(t, "http %d", backend.Entries[2].Format)
+	assert.Equal(t, map[string]interface{}{FieldNameKey: "http"}, backend.Entries[2].Fields)
+
+	assert.Equal(t, map[string]Level{"": LevelInfo, "db": LevelDebug, "db.pool": LevelError}, log.ModuleLevels())
+
+	require.NoError(t, log.UnsetModuleLevel("db"))
+	assert.Equal(t, LevelInfo, db.Level())
+	assert.Equal(t, LevelError, pool.Level())
+	assert.Equal(t, LevelInfo, backend.Level())
+	assert.Error(t, log.UnsetModuleLevel(""))
+}
+
+func TestHierarchy_SetModuleLevel(t *testing.T) {
+	log := NewHierarchy(NewInMemory(LevelInfo))
+
+	assert.Error(t, log.SetModuleLevel("db", Level(42)))
+
+	require.NoError(t, log.SetModuleLevel("db", LevelQuiet))
+	assert.False(t, log.Named("db").Enabled(LevelError))
+	assert.Equal(t, LevelQuiet, log.ModuleLevel("db.pool"))
+	assert.Equal(t, LevelInfo, log.ModuleLevel("dbx"))
+}
+
+func TestHierarchy_SetModuleLevel_failure(t *testing.T) {
+	backend := &levelErrorLogger{Logger: NewInMemory(LevelInfo)}
+	log := NewHierarchy(backend)
+
+	require.Error(t, log.SetModuleLevel("db", LevelDebug))
+	assert.Equal(t, LevelInfo, log.ModuleLevel("db"), "levels should be left untouched")
+}
+
+// levelErrorLogger fails to set any level.
+type levelErrorLogger struct{ Logger }
+
+func (levelErrorLogger) SetLevel(Level) error { return errors.New("boom") }
@@ -0,0 +1,30 @@
+package logger
+
+import (
+	"time"
+)
+
+// Hook is notified of each entry written by the loggers it is registered on,
+// for instance to forward errors to an alerting system or to count entries.
+// Hooks are registered through an option of each logger, or with
+// InMemory.AddHook, and are called synchronously by the goroutine that
+// logs: they must be safe for concurrent use and should return quickly.
+type Hook interface {
+	Fire(entry HookEntry)
+}
+
+// HookFunc is a function that implements Hook.
+type HookFunc func(entry HookEntry)
+
+// Fire implements Hook.
+func (f HookFunc) Fire(entry HookEntry) { f(entry) }
+
+// HookEntry describes an entry given to hooks.
+// Fields contain all the fields of the entry, including the name of the
+// logger in FieldNameKey if any, and must not be modified by hooks.
+type HookEntry struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Fields  map[string]interface{}
+}
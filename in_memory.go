@@ -1,11 +1,18 @@
 package logger
 
+import (
+	"fmt"
+	"time"
+)
+
 // InMemory defines a memory logger.
 // It is designed for tests purposes only.
 type InMemory struct {
 	parent  *InMemory
+	name    string
 	fields  map[string]interface{}
 	level   Level
+	hooks   []Hook
 	Entries []InMemoryEntry
 }
 
@@ -35,40 +42,73 @@ func (n *InMemory) Reset() {
 	n.Entries = []InMemoryEntry{}
 }
 
+// AddHook registers hooks fired with the entries stored by this logger,
+// which include the entries of the loggers derived from it.
+func (n *InMemory) AddHook(hooks ...Hook) {
+	n.hooks = append(n.hooks, hooks...)
+}
+
 // SetLevel implements Logger for Memory.
 func (n *InMemory) SetLevel(lvl Level) error {
 	n.level = lvl
 	return nil
 }
 
+// Level implements Logger for Memory.
+func (n *InMemory) Level() Level { return n.level }
+
+// Enabled implements Logger for Memory.
+func (n *InMemory) Enabled(lvl Level) bool { return lvl >= n.level && lvl < LevelQuiet }
+
 // Debug implements Logger for Memory.
 func (n *InMemory) Debug(args ...interface{}) { n.log(nil, LevelDebug, "", args) }
 
 // Debugf implements Logger for Memory.
 func (n *InMemory) Debugf(format string, args ...interface{}) { n.log(nil, LevelDebug, format, args) }
 
+// Debugw implements Logger for Memory.
+func (n *InMemory) Debugw(msg string, keysAndValues ...interface{}) {
+	n.log(KeysAndValuesToFields(keysAndValues), LevelDebug, "", []interface{}{msg})
+}
+
 // Info implements Logger for Memory.
 func (n *InMemory) Info(args ...interface{}) { n.log(nil, LevelInfo, "", args) }
 
 // Infof implements Logger for Memory.
 func (n *InMemory) Infof(format string, args ...interface{}) { n.log(nil, LevelInfo, format, args) }
 
+// Infow implements Logger for Memory.
+func (n *InMemory) Infow(msg string, keysAndValues ...interface{}) {
+	n.log(KeysAndValuesToFields(keysAndValues), LevelInfo, "", []interface{}{msg})
+}
+
 // Warn implements Logger for Memory.
 func (n *InMemory) Warn(args ...interface{}) { n.log(nil, LevelWarn, "", args) }
 
 // Warnf implements Logger for Memory.
 func (n *InMemory) Warnf(format string, args ...interface{}) { n.log(nil, LevelWarn, format, args) }
 
+// Warnw implements Logger for Memory.
+func (n *InMemory) Warnw(msg string, keysAndValues ...interface{}) {
+	n.log(KeysAndValuesToFields(keysAndValues), LevelWarn, "", []interface{}{msg})
+}
+
 // Error implements Logger for Memory.
 func (n *InMemory) Error(args ...interface{}) { n.log(nil, LevelError, "", args) }
 
 // Errorf implements Logger for Memory.
 func (n *InMemory) Errorf(format string, args ...interface{}) { n.log(nil, LevelError, format, args) }
 
+// Errorw implements Logger for Memory.
+func (n *InMemory) Errorw(msg string, keysAndValues ...interface{}) {
+	n.log(KeysAndValuesToFields(keysAndValues), LevelError, "", []interface{}{msg})
+}
+
 // WithField implements Logger for Memory.
 func (n *InMemory) WithField(key string, value interface{}) Logger {
 	child := NewInMemory(n.level)
 	child.parent = n
+	child.name = n.name
 
 	child.fields[key] = value
 	return child
@@ -78,6 +118,7 @@ func (n *InMemory) WithField(key string, value interface{}) Logger {
 func (n *InMemory) WithFields(fields map[string]interface{}) Logger {
 	child := NewInMemory(n.level)
 	child.parent = n
+	child.name = n.name
 
 	for key, value := range fields {
 		child.fields[key] = value
@@ -85,31 +126,74 @@ func (n *InMemory) WithFields(fields map[string]interface{}) Logger {
 	return child
 }
 
+// With implements Logger for Memory.
+// Errors are stored using the same fields as WithError.
+func (n *InMemory) With(fields ...Field) Logger {
+	return n.WithFields(FieldsToMap(ExpandErrorFields(fields)))
+}
+
 // WithError implements Logger for Memory.
+// The error is stored using the same fields as other backends, see ErrorFields.
 func (n *InMemory) WithError(err error) Logger {
-	return n.WithField(FieldErrorKey, err)
+	return n.With(ErrorFields(err)...)
+}
+
+// Named implements Logger for Memory.
+// The name is stored in the FieldNameKey field.
+func (n *InMemory) Named(name string) Logger {
+	name = JoinNames(n.name, name)
+
+	child := n.WithField(FieldNameKey, name).(*InMemory)
+	child.name = name
+	return child
 }
 
 func (n *InMemory) log(childFields map[string]interface{}, lvl Level, format string, args []interface{}) {
 	var fields = make(map[string]interface{})
 
-	for key, value := range childFields {
+	for key, value := range n.fields {
 		fields[key] = value
 	}
-	for key, value := range n.fields {
+	for key, value := range childFields {
 		fields[key] = value
 	}
 
 	if lvl >= n.level {
-		n.Entries = append(n.Entries, InMemoryEntry{
+		entryFields := make(map[string]interface{}, len(fields))
+		for key, value := range fields {
+			entryFields[key] = ResolveObject(ResolveLazy(value))
+		}
+
+		entry := InMemoryEntry{
 			Level:  lvl,
 			Format: format,
-			Args:   args,
-			Fields: fields,
-		})
+			Args:   ResolveLazyArgs(args),
+			Fields: entryFields,
+		}
+		n.Entries = append(n.Entries, entry)
+
+		if len(n.hooks) > 0 {
+			hookEntry := HookEntry{Time: time.Now(), Level: lvl, Message: entry.message(), Fields: entryFields}
+			for _, hook := range n.hooks {
+				hook.Fire(hookEntry)
+			}
+		}
 	}
 
 	if n.parent != nil {
 		n.parent.log(fields, lvl, format, args)
 	}
 }
+
+// message returns the message of the entry, as other loggers would write it.
+func (e InMemoryEntry) message() string {
+	if e.Format != "" {
+		return fmt.Sprintf(e.Format, e.Args...)
+	}
+	if len(e.Args) == 1 {
+		if msg, ok := e.Args[0].(string); ok {
+			return msg
+		}
+	}
+	return fmt.Sprint(e.Args...)
+}
@@ -2,6 +2,7 @@ package logger
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,6 +33,16 @@ func TestInMemory_SetLevel(t *testing.T) {
 	assert.Equal(t, LevelError, log.level)
 }
 
+func TestInMemory_Enabled(t *testing.T) {
+	log := NewInMemory(LevelWarn)
+
+	assert.Equal(t, LevelWarn, log.Level())
+	assert.False(t, log.Enabled(LevelInfo))
+	assert.True(t, log.Enabled(LevelWarn))
+	assert.True(t, log.Enabled(LevelError))
+	assert.False(t, log.Enabled(LevelQuiet))
+}
+
 func TestInMemory_WithField(t *testing.T) {
 	lR := NewInMemory(LevelDebug)
 	llF := lR.WithField("hello", "world")
@@ -55,6 +66,19 @@ func TestInMemory_WithFields(t *testing.T) {
 	assert.Equal(t, fields, lF.fields)
 }
 
+func TestInMemory_With(t *testing.T) {
+	lR := NewInMemory(LevelDebug)
+	llF := lR.With(String("hello", "world"), Int("answer", 42))
+	lF, _ := llF.(*InMemory)
+
+	assert.NotEqual(t, lR, lF)
+	assert.Equal(t, lR, lF.parent)
+	assert.Equal(t, map[string]interface{}{
+		"hello":  "world",
+		"answer": int64(42),
+	}, lF.fields)
+}
+
 func TestInMemory_WithError(t *testing.T) {
 	err := errors.New("hello world")
 	lR := NewInMemory(LevelDebug)
@@ -64,10 +88,84 @@ func TestInMemory_WithError(t *testing.T) {
 	assert.NotEqual(t, lR, lF)
 	assert.Equal(t, lR, lF.parent)
 	assert.Equal(t, map[string]interface{}{
-		FieldErrorKey: err,
+		FieldErrorKey:     "hello world",
+		FieldErrorTypeKey: "*errors.errorString",
 	}, lF.fields)
 }
 
+func TestInMemory_With_error(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	err := fmt.Errorf("wrap: %w", errors.New("eww"))
+
+	log.With(Err(err)).Info("with")
+	log.WithError(err).Info("with error")
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, log.Entries[1].Fields, log.Entries[0].Fields)
+	assert.Equal(t, "*fmt.wrapError", log.Entries[0].Fields[FieldErrorTypeKey])
+}
+
+func TestInMemory_WithError_fieldsCarrier(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	log.WithError(fmt.Errorf("unable to order: %w", fieldsError{
+		err:    errors.New("eww"),
+		fields: map[string]interface{}{"order_id": "o-42"},
+	})).Error("error")
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, "o-42", log.Entries[0].Fields["order_id"])
+}
+
+func TestInMemory_object(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	user := testUser{id: 42, password: "secret", address: testAddress{city: "Paris"}}
+
+	log.With(Object("user", user)).Infow("info", "address", user.address)
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"user": map[string]interface{}{
+			"id":      int64(42),
+			"address": map[string]interface{}{"city": "Paris"},
+		},
+		"address": map[string]interface{}{"city": "Paris"},
+	}, log.Entries[0].Fields)
+}
+
+func TestInMemory_AddHook(t *testing.T) {
+	var entries []HookEntry
+
+	log := NewInMemory(LevelInfo)
+	log.AddHook(HookFunc(func(entry HookEntry) { entries = append(entries, entry) }))
+
+	child := log.Named("db").WithField("hello", "world")
+	child.Debug("debug")
+	child.Warnf("warn %d", 42)
+	child.Errorw("error", "answer", 42)
+
+	require.Len(t, entries, 2)
+	assert.False(t, entries[0].Time.IsZero())
+	assert.Equal(t, LevelWarn, entries[0].Level)
+	assert.Equal(t, "warn 42", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{"hello": "world", FieldNameKey: "db"}, entries[0].Fields)
+	assert.Equal(t, LevelError, entries[1].Level)
+	assert.Equal(t, "error", entries[1].Message)
+	assert.Equal(t, map[string]interface{}{"hello": "world", "answer": 42, FieldNameKey: "db"}, entries[1].Fields)
+}
+
+func TestInMemory_Named(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	log.Named("db").WithField("hello", "world").Named("pool").Info("info")
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"hello":      "world",
+		FieldNameKey: "db.pool",
+	}, log.Entries[0].Fields)
+}
+
 func TestInMemory_log(t *testing.T) {
 	log := NewInMemory(LevelInfo)
 
@@ -100,6 +198,16 @@ func TestInMemory_log(t *testing.T) {
 		assert.Equal(t, []interface{}{"toto"}, log.Entries[0].Args)
 	})
 
+	t.Run("child fields take precedence", func(t *testing.T) {
+		log.Reset()
+
+		child, _ := log.WithField("key", "parent").WithField("key", "child").(*InMemory)
+		child.Infow("info", "key", "call")
+
+		require.Len(t, log.Entries, 1)
+		assert.Equal(t, map[string]interface{}{"key": "call"}, log.Entries[0].Fields)
+	})
+
 	t.Run("child", func(t *testing.T) {
 		log.Reset()
 
@@ -122,23 +230,28 @@ func TestInMemory_Log(t *testing.T) {
 	tests := map[string]struct {
 		logFunc  func(args ...interface{})
 		logFFunc func(format string, args ...interface{})
+		logWFunc func(msg string, keysAndValues ...interface{})
 		level    Level
 	}{
 		"debug": {
 			logFunc:  log.Debug,
 			logFFunc: log.Debugf,
+			logWFunc: log.Debugw,
 			level:    LevelDebug,
 		}, "info": {
 			logFunc:  log.Info,
 			logFFunc: log.Infof,
+			logWFunc: log.Infow,
 			level:    LevelInfo,
 		}, "warn": {
 			logFunc:  log.Warn,
 			logFFunc: log.Warnf,
+			logWFunc: log.Warnw,
 			level:    LevelWarn,
 		}, "error": {
 			logFunc:  log.Error,
 			logFFunc: log.Errorf,
+			logWFunc: log.Errorw,
 			level:    LevelError,
 		},
 	}
@@ -156,6 +269,7 @@ func TestInMemory_Log(t *testing.T) {
 			// try to log with not enough verbosity
 			test.logFunc("anything", 42)
 			test.logFFunc("anything %d", 42)
+			test.logWFunc("anything", "answer", 42)
 			assert.Empty(t, log.Entries, "the verbosity was not supposed to be high enough to display something")
 
 			// now we should see logs
@@ -173,6 +287,13 @@ func TestInMemory_Log(t *testing.T) {
 			assert.Equal(t, test.level, log.Entries[1].Level)
 			assert.Equal(t, log.Entries[1].Format, "toto %d")
 			assert.Equal(t, log.Entries[1].Args, []interface{}{42})
+
+			test.logWFunc("toto", "answer", 42)
+			require.Len(t, log.Entries, 3)
+			assert.Equal(t, test.level, log.Entries[2].Level)
+			assert.Empty(t, log.Entries[2].Format, "format should only be set in the logF variant")
+			assert.Equal(t, log.Entries[2].Args, []interface{}{"toto"})
+			assert.Equal(t, log.Entries[2].Fields, map[string]interface{}{"answer": 42})
 		})
 	}
 }
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// LazyValue is a value which is only computed when the entry
+// that carries it is written, either as a log argument or as a field.
+// The value is computed at most once.
+type LazyValue struct {
+	once  sync.Once
+	fn    func() interface{}
+	value interface{}
+}
+
+// Lazy returns a value computed by fn only if the entry using it is written.
+func Lazy(fn func() interface{}) *LazyValue {
+	return &LazyValue{fn: fn}
+}
+
+// Value computes (once) and returns the value.
+func (v *LazyValue) Value() interface{} {
+	v.once.Do(func() {
+		v.value = v.fn()
+		v.fn = nil
+	})
+	return v.value
+}
+
+// String implements fmt.Stringer.
+func (v *LazyValue) String() string {
+	return fmt.Sprint(ResolveObject(v.Value()))
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *LazyValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ResolveObject(v.Value()))
+}
+
+// LogValue implements slog.LogValuer.
+func (v *LazyValue) LogValue() slog.Value {
+	return slog.AnyValue(ResolveObject(v.Value()))
+}
+
+// ResolveLazy returns the computed value if v is a lazy value, v otherwise.
+func ResolveLazy(v interface{}) interface{} {
+	if lazy, ok := v.(*LazyValue); ok {
+		return lazy.Value()
+	}
+	return v
+}
+
+// ResolveLazyArgs returns args with all lazy values computed.
+// The provided slice is returned as is if it does not contain any lazy value.
+func ResolveLazyArgs(args []interface{}) []interface{} {
+	var resolved []interface{}
+
+	for i, arg := range args {
+		lazy, ok := arg.(*LazyValue)
+		if !ok {
+			continue
+		}
+		if resolved == nil {
+			resolved = make([]interface{}, len(args))
+			copy(resolved, args)
+		}
+		resolved[i] = lazy.Value()
+	}
+
+	if resolved == nil {
+		return args
+	}
+	return resolved
+}
@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCountedLazy(value interface{}) (*LazyValue, *int) {
+	var calls int
+	return Lazy(func() interface{} {
+		calls++
+		return value
+	}), &calls
+}
+
+func Test_Lazy(t *testing.T) {
+	lazy, calls := newCountedLazy(map[string]int{"answer": 42})
+	assert.Equal(t, 0, *calls)
+
+	assert.Equal(t, map[string]int{"answer": 42}, lazy.Value())
+	assert.Equal(t, "map[answer:42]", lazy.String())
+
+	raw, err := json.Marshal(lazy)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"answer":42}`, string(raw))
+
+	assert.Equal(t, map[string]int{"answer": 42}, lazy.LogValue().Any())
+	assert.Equal(t, 1, *calls, "value should be computed only once")
+}
+
+func Test_Lazy_object(t *testing.T) {
+	lazy, _ := newCountedLazy(testAddress{city: "Paris"})
+
+	assert.Equal(t, "map[city:Paris]", lazy.String())
+
+	raw, err := json.Marshal(lazy)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"city":"Paris"}`, string(raw))
+
+	assert.Equal(t, map[string]interface{}{"city": "Paris"}, lazy.LogValue().Any())
+}
+
+func Test_ResolveLazy(t *testing.T) {
+	lazy, _ := newCountedLazy(42)
+
+	assert.Equal(t, 42, ResolveLazy(lazy))
+	assert.Equal(t, "hello", ResolveLazy("hello"))
+}
+
+func Test_ResolveLazyArgs(t *testing.T) {
+	t.Run("without lazy values", func(t *testing.T) {
+		args := []interface{}{"hello", 42}
+		resolved := ResolveLazyArgs(args)
+
+		assert.Equal(t, args, resolved)
+		assert.Equal(t, &args[0], &resolved[0], "slice should not be copied")
+	})
+
+	t.Run("with lazy values", func(t *testing.T) {
+		lazy, _ := newCountedLazy(42)
+		args := []interface{}{"hello", lazy}
+
+		assert.Equal(t, []interface{}{"hello", 42}, ResolveLazyArgs(args))
+		assert.Equal(t, lazy, args[1], "original slice should not be modified")
+	})
+}
+
+func TestInMemory_lazy(t *testing.T) {
+	log := NewInMemory(LevelInfo)
+
+	lazyArg, argCalls := newCountedLazy("arg")
+	lazyField, fieldCalls := newCountedLazy("field")
+
+	child := log.WithField("lazy", lazyField)
+	child.Debug(lazyArg)
+	child.Debugw("msg", "lazy", lazyArg)
+	assert.Empty(t, log.Entries)
+	assert.Equal(t, 0, *argCalls)
+	assert.Equal(t, 0, *fieldCalls)
+
+	child.Info(lazyArg)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, []interface{}{"arg"}, log.Entries[0].Args)
+	assert.Equal(t, map[string]interface{}{"lazy": "field"}, log.Entries[0].Fields)
+}
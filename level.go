@@ -105,3 +105,21 @@ func LogFAtLevelFunc(log Logger, l Level) func(string, ...interface{}) {
 		return log.Infof
 	}
 }
+
+// LogWAtLevelFunc is the same as LogAtLevelFunc but with key-value pairs.
+func LogWAtLevelFunc(log Logger, l Level) func(string, ...interface{}) {
+	switch l {
+	case LevelDebug:
+		return log.Debugw
+	case LevelInfo:
+		return log.Infow
+	case LevelWarn:
+		return log.Warnw
+	case LevelError:
+		return log.Errorw
+	case LevelQuiet:
+		return func(string, ...interface{}) {}
+	default:
+		return log.Infow
+	}
+}
@@ -168,3 +168,42 @@ func Test_LogFAtLevelFunc(t *testing.T) {
 		})
 	}
 }
+
+func Test_LogWAtLevelFunc(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	tests := map[string]struct {
+		level             Level
+		expectedNoEntries bool
+	}{
+		"debug level": {
+			level: LevelDebug,
+		}, "info level": {
+			level: LevelInfo,
+		}, "warn level": {
+			level: LevelWarn,
+		}, "error level": {
+			level: LevelError,
+		}, "quiet level": {
+			level:             LevelQuiet,
+			expectedNoEntries: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			log.Reset()
+
+			LogWAtLevelFunc(log, test.level)("log", "answer", 42)
+
+			if test.expectedNoEntries {
+				require.Empty(t, log.Entries)
+			} else {
+				require.Len(t, log.Entries, 1)
+				assert.Equal(t, test.level, log.Entries[0].Level)
+				assert.Equal(t, []interface{}{"log"}, log.Entries[0].Args)
+				assert.Equal(t, map[string]interface{}{"answer": 42}, log.Entries[0].Fields)
+			}
+		})
+	}
+}
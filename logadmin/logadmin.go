@@ -0,0 +1,163 @@
+// Package logadmin exposes a net/http handler to read and
+// update the levels of a logger.Logger at runtime.
+package logadmin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/krostar/logger"
+)
+
+// ModuleLeveler is implemented by loggers which handle levels per
+// named module, like logger.Hierarchy.
+type ModuleLeveler interface {
+	ModuleLevels() map[string]logger.Level
+	SetModuleLevel(name string, lvl logger.Level) error
+	UnsetModuleLevel(name string) error
+}
+
+// State is the representation of the levels, read by GET requests
+// and written by PUT requests.
+type State struct {
+	// Level is the level of the logger.
+	Level *string `json:"level,omitempty"`
+	// Modules contains the levels configured per module name, only
+	// if the logger implements ModuleLeveler. On PUT requests, a
+	// null or empty level removes the configured level of the module.
+	Modules map[string]*string `json:"modules,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// New returns a handler that serves the levels of the provided logger as JSON
+// on GET requests, and updates them on PUT requests. Levels are validated
+// with logger.ParseLevel and applied with Logger.SetLevel or, for modules,
+// with ModuleLeveler.SetModuleLevel.
+func New(log logger.Logger) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var state State
+
+			decoder := json.NewDecoder(r.Body)
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&state); err != nil {
+				writeJSON(rw, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("unable to decode request: %v", err)})
+				return
+			}
+
+			if err := apply(log, state); err != nil {
+				status := http.StatusInternalServerError
+				if errors.As(err, new(*badRequestError)) {
+					status = http.StatusBadRequest
+				}
+				writeJSON(rw, status, errorResponse{Error: err.Error()})
+				return
+			}
+		default:
+			rw.Header().Set("Allow", http.MethodGet+", "+http.MethodPut)
+			writeJSON(rw, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+
+		writeJSON(rw, http.StatusOK, currentState(log))
+	})
+}
+
+func currentState(log logger.Logger) State {
+	lvl := log.Level().String()
+	state := State{Level: &lvl}
+
+	if leveler, ok := log.(ModuleLeveler); ok {
+		state.Modules = make(map[string]*string)
+		for name, lvl := range leveler.ModuleLevels() {
+			if name == "" { // root level
+				continue
+			}
+			lvl := lvl.String()
+			state.Modules[name] = &lvl
+		}
+	}
+
+	return state
+}
+
+// apply validates all the levels of the state before applying any of them.
+func apply(log logger.Logger, state State) error {
+	var (
+		lvl     logger.Level
+		modules = make(map[string]*logger.Level, len(state.Modules))
+		err     error
+	)
+
+	if state.Level != nil {
+		if lvl, err = parseLevel(*state.Level); err != nil {
+			return &badRequestError{err: fmt.Errorf("invalid level: %w", err)}
+		}
+	}
+
+	leveler, ok := log.(ModuleLeveler)
+	if len(state.Modules) > 0 && !ok {
+		return &badRequestError{err: errors.New("module levels are not supported by this logger")}
+	}
+
+	for name, moduleLvl := range state.Modules {
+		if name == "" {
+			return &badRequestError{err: errors.New("module name cannot be empty")}
+		}
+		if moduleLvl == nil || *moduleLvl == "" {
+			modules[name] = nil
+			continue
+		}
+
+		lvl, err := parseLevel(*moduleLvl)
+		if err != nil {
+			return &badRequestError{err: fmt.Errorf("invalid level for module %q: %w", name, err)}
+		}
+		modules[name] = &lvl
+	}
+
+	if state.Level != nil {
+		if err := log.SetLevel(lvl); err != nil {
+			return fmt.Errorf("unable to set level: %w", err)
+		}
+	}
+
+	for name, lvl := range modules {
+		if lvl == nil {
+			err = leveler.UnsetModuleLevel(name)
+		} else {
+			err = leveler.SetModuleLevel(name, *lvl)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to set level of module %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// parseLevel is logger.ParseLevel without the empty string fallback.
+func parseLevel(str string) (logger.Level, error) {
+	if str == "" {
+		return logger.LevelInfo, errors.New("empty level")
+	}
+	return logger.ParseLevel(str)
+}
+
+func writeJSON(rw http.ResponseWriter, status int, body interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(body)
+}
+
+type badRequestError struct{ err error }
+
+func (e *badRequestError) Error() string { return e.err.Error() }
+func (e *badRequestError) Unwrap() error { return e.err }
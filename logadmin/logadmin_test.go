@@ -0,0 +1,124 @@
+package logadmin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func serve(t *testing.T, handler http.Handler, method, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(method, "http://local/log/level", strings.NewReader(body))
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	return w
+}
+
+func Test_New(t *testing.T) {
+	log := logger.NewInMemory(logger.LevelInfo)
+	handler := New(log)
+
+	w := serve(t, handler, http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"level":"info"}`, w.Body.String())
+
+	w = serve(t, handler, http.MethodPut, `{"level":"debug"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"level":"debug"}`, w.Body.String())
+	assert.Equal(t, logger.LevelDebug, log.Level())
+
+	w = serve(t, handler, http.MethodPut, `{"modules":{"db":"debug"}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "not supported")
+}
+
+func Test_New_modules(t *testing.T) {
+	log := logger.NewHierarchy(logger.NewInMemory(logger.LevelInfo))
+	handler := New(log)
+
+	w := serve(t, handler, http.MethodPut, `{"level":"warn","modules":{"db":"debug","http":"error"}}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"level":"warn","modules":{"db":"debug","http":"error"}}`, w.Body.String())
+	assert.Equal(t, logger.LevelDebug, log.Named("db").Named("pool").Level())
+
+	w = serve(t, handler, http.MethodPut, `{"modules":{"db":null,"http":""}}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"level":"warn"}`, w.Body.String())
+	assert.Equal(t, logger.LevelWarn, log.Named("db").Level())
+}
+
+func Test_New_errors(t *testing.T) {
+	tests := map[string]struct {
+		method         string
+		body           string
+		expectedStatus int
+	}{
+		"method not allowed": {
+			method:         http.MethodPost,
+			expectedStatus: http.StatusMethodNotAllowed,
+		}, "invalid json": {
+			method:         http.MethodPut,
+			body:           `{"level":`,
+			expectedStatus: http.StatusBadRequest,
+		}, "unknown field": {
+			method:         http.MethodPut,
+			body:           `{"verbosity":"debug"}`,
+			expectedStatus: http.StatusBadRequest,
+		}, "invalid level": {
+			method:         http.MethodPut,
+			body:           `{"level":"verbose"}`,
+			expectedStatus: http.StatusBadRequest,
+		}, "empty level": {
+			method:         http.MethodPut,
+			body:           `{"level":""}`,
+			expectedStatus: http.StatusBadRequest,
+		}, "invalid module level": {
+			method:         http.MethodPut,
+			body:           `{"level":"debug","modules":{"db":"verbose"}}`,
+			expectedStatus: http.StatusBadRequest,
+		}, "empty module name": {
+			method:         http.MethodPut,
+			body:           `{"modules":{"":"debug"}}`,
+			expectedStatus: http.StatusBadRequest,
+		}, "set level failure": {
+			method:         http.MethodPut,
+			body:           `{"level":"debug"}`,
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			log := logger.NewHierarchy(&failingLogger{Logger: logger.NewInMemory(logger.LevelInfo)})
+
+			w := serve(t, New(log), test.method, test.body)
+			assert.Equal(t, test.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), `"error"`)
+			assert.Equal(t, logger.LevelInfo, log.Level(), "level should be left untouched")
+		})
+	}
+
+	t.Run("allow header", func(t *testing.T) {
+		w := serve(t, New(logger.Noop{}), http.MethodDelete, "")
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Equal(t, "GET, PUT", w.Header().Get("Allow"))
+	})
+}
+
+// failingLogger fails to set any level.
+type failingLogger struct{ logger.Logger }
+
+func (failingLogger) SetLevel(logger.Level) error { return errors.New("boom") }
@@ -2,34 +2,92 @@
 // and exposes useful function to switch back to a writer, or to a standard logger.
 package logger
 
+import (
+	"fmt"
+)
+
 // Logger defines the way logs can be handled.
 type Logger interface {
 	// Update apply the configuration on the logger.
 	SetLevel(Level) error
+	// Level returns the minimum level of the logger.
+	Level() Level
+	// Enabled returns true if entries at the provided level would be logged.
+	Enabled(Level) bool
 
 	// Debug logs a message at the 'debug' level.
 	Debug(args ...interface{})
 	Debugf(format string, args ...interface{})
+	Debugw(msg string, keysAndValues ...interface{})
 
 	// Info logs a message at the 'info' level.
 	Info(args ...interface{})
 	Infof(format string, args ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
 
 	// Info logs a message at the 'warn' level.
 	Warn(args ...interface{})
 	Warnf(format string, args ...interface{})
+	Warnw(msg string, keysAndValues ...interface{})
 
 	// Error logs a message at the 'error' level.
 	Error(args ...interface{})
 	Errorf(format string, args ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
 
 	// WithField adds a field to the logging context.
 	WithField(key string, value interface{}) Logger
 	// WithFields adds multiple fields to the logging context.
 	WithFields(fields map[string]interface{}) Logger
-	// WithError adds an error field to the logging context.
+	// With adds strongly typed fields to the logging context.
+	With(fields ...Field) Logger
+	// WithError adds fields describing the error to the logging context, see ErrorFields.
 	WithError(err error) Logger
+	// Named returns a child logger whose name is the parent name suffixed by name.
+	Named(name string) Logger
 }
 
 // FieldErrorKey is the name of the field set by WithError.
 const FieldErrorKey = "error"
+
+// FieldNameKey is the name of the field set by Named.
+const FieldNameKey = "logger"
+
+// JoinNames returns the name of a logger named name derived from a logger named parent.
+// Names are separated by dots, like "db.pool".
+func JoinNames(parent, name string) string {
+	switch {
+	case parent == "":
+		return name
+	case name == "":
+		return parent
+	default:
+		return parent + "." + name
+	}
+}
+
+// FieldBadKey is the name of the field used by KeysAndValuesToFields
+// to store a value that comes without a key.
+const FieldBadKey = "!BADKEY"
+
+// KeysAndValuesToFields converts loosely typed key-value pairs, as given to
+// the Debugw, Infow, Warnw and Errorw methods, to fields.
+// Keys that are not strings are converted using fmt.Sprint.
+func KeysAndValuesToFields(keysAndValues []interface{}) map[string]interface{} {
+	fields := make(map[string]interface{}, (len(keysAndValues)+1)/2)
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i == len(keysAndValues)-1 {
+			fields[FieldBadKey] = keysAndValues[i]
+			break
+		}
+
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = fmt.Sprint(keysAndValues[i])
+		}
+		fields[key] = keysAndValues[i+1]
+	}
+
+	return fields
+}
@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_KeysAndValuesToFields(t *testing.T) {
+	tests := map[string]struct {
+		keysAndValues  []interface{}
+		expectedFields map[string]interface{}
+	}{
+		"empty": {
+			expectedFields: map[string]interface{}{},
+		}, "pairs": {
+			keysAndValues:  []interface{}{"hello", "world", "answer", 42},
+			expectedFields: map[string]interface{}{"hello": "world", "answer": 42},
+		}, "non-string key": {
+			keysAndValues:  []interface{}{42, "answer"},
+			expectedFields: map[string]interface{}{"42": "answer"},
+		}, "dangling value": {
+			keysAndValues:  []interface{}{"hello", "world", "alone"},
+			expectedFields: map[string]interface{}{"hello": "world", FieldBadKey: "alone"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expectedFields, KeysAndValuesToFields(test.keysAndValues))
+		})
+	}
+}
+
+func Test_JoinNames(t *testing.T) {
+	assert.Equal(t, "db", JoinNames("", "db"))
+	assert.Equal(t, "db", JoinNames("db", ""))
+	assert.Equal(t, "db.pool", JoinNames("db", "pool"))
+}
@@ -0,0 +1,98 @@
+// Package logmetrics exposes the counts of a logger.Metrics
+// through expvar and through a Prometheus compatible net/http handler.
+package logmetrics
+
+import (
+	"expvar"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/krostar/logger"
+)
+
+const (
+	// MetricEntriesTotal is the name of the Prometheus counter of written entries.
+	MetricEntriesTotal = "log_entries_total"
+	// MetricEntriesDroppedTotal is the name of the Prometheus counter of dropped entries.
+	MetricEntriesDroppedTotal = "log_entries_dropped_total"
+)
+
+// Var returns an expvar variable exposing the counts of m, indexed by
+// "emitted" or "dropped", then by logger name and finally by level:
+//
+//	{"emitted": {"db": {"error": 3}}, "dropped": {"db": {"debug": 42}}}
+//
+// The empty name designates the root logger. It is meant to be published
+// with expvar.Publish.
+func Var(m *logger.Metrics) expvar.Var {
+	return expvar.Func(func() interface{} {
+		emitted := make(map[string]map[string]uint64)
+		dropped := make(map[string]map[string]uint64)
+
+		for _, count := range m.Counts() {
+			if emitted[count.Name] == nil {
+				emitted[count.Name] = make(map[string]uint64)
+				dropped[count.Name] = make(map[string]uint64)
+			}
+			emitted[count.Name][count.Level.String()] = count.Emitted
+			dropped[count.Name][count.Level.String()] = count.Dropped
+		}
+
+		return map[string]interface{}{"emitted": emitted, "dropped": dropped}
+	})
+}
+
+// Handler returns a handler that writes the counts of m on GET requests using
+// the Prometheus text exposition format, with the MetricEntriesTotal and
+// MetricEntriesDroppedTotal counters labeled by logger name and level.
+func Handler(m *logger.Metrics) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			rw.Header().Set("Allow", http.MethodGet+", "+http.MethodHead)
+			http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte(exposition(m.Counts())))
+	})
+}
+
+func exposition(counts []logger.EntriesCount) string {
+	var b strings.Builder
+
+	for _, metric := range []struct {
+		name  string
+		help  string
+		value func(logger.EntriesCount) uint64
+	}{
+		{
+			name:  MetricEntriesTotal,
+			help:  "Number of log entries written, per logger name and level.",
+			value: func(count logger.EntriesCount) uint64 { return count.Emitted },
+		}, {
+			name:  MetricEntriesDroppedTotal,
+			help:  "Number of log entries dropped as their level was disabled, per logger name and level.",
+			value: func(count logger.EntriesCount) uint64 { return count.Dropped },
+		},
+	} {
+		fmt.Fprintf(&b, "# HELP %s %s\n", metric.name, metric.help)
+		fmt.Fprintf(&b, "# TYPE %s counter\n", metric.name)
+		for _, count := range counts {
+			fmt.Fprintf(&b, "%s{logger=\"%s\",level=\"%s\"} %d\n",
+				metric.name, escapeLabelValue(count.Name), count.Level, metric.value(count),
+			)
+		}
+	}
+
+	return b.String()
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes the label value as required by the Prometheus text format.
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
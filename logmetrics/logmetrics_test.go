@@ -0,0 +1,68 @@
+package logmetrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func newMetrics() *logger.Metrics {
+	metrics := logger.NewMetrics(logger.NewInMemory(logger.LevelInfo))
+	metrics.Debug("debug")
+	metrics.Error("error")
+	metrics.Named(`d"b`).Warn("warn")
+	return metrics
+}
+
+func Test_Var(t *testing.T) {
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(Var(newMetrics()).String()), &output))
+
+	assert.Equal(t, map[string]interface{}{
+		"emitted": map[string]interface{}{
+			"":    map[string]interface{}{"debug": float64(0), "error": float64(1)},
+			`d"b`: map[string]interface{}{"warn": float64(1)},
+		},
+		"dropped": map[string]interface{}{
+			"":    map[string]interface{}{"debug": float64(1), "error": float64(0)},
+			`d"b`: map[string]interface{}{"warn": float64(0)},
+		},
+	}, output)
+}
+
+func Test_Handler(t *testing.T) {
+	handler := Handler(newMetrics())
+
+	t.Run("get", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://local/metrics", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `# HELP log_entries_total Number of log entries written, per logger name and level.
+# TYPE log_entries_total counter
+log_entries_total{logger="",level="debug"} 0
+log_entries_total{logger="",level="error"} 1
+log_entries_total{logger="d\"b",level="warn"} 1
+# HELP log_entries_dropped_total Number of log entries dropped as their level was disabled, per logger name and level.
+# TYPE log_entries_dropped_total counter
+log_entries_dropped_total{logger="",level="debug"} 1
+log_entries_dropped_total{logger="",level="error"} 0
+log_entries_dropped_total{logger="d\"b",level="warn"} 0
+`, w.Body.String())
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "http://local/metrics", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Equal(t, "GET, HEAD", w.Header().Get("Allow"))
+	})
+}
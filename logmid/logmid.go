@@ -31,6 +31,7 @@ func New(log logger.Logger, opts ...Option) func(http.Handler) http.Handler {
 
 			ctx = context.WithValue(ctx, ctxLogErrorsKey, &err)
 			ctx = context.WithValue(ctx, ctxLogFieldsKey, fields)
+			ctx = logger.WithContext(ctx, log)
 			r = r.WithContext(ctx)
 
 			next.ServeHTTP(rw, r)
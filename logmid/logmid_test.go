@@ -20,8 +20,9 @@ func Test_Middleware(t *testing.T) {
 	r := httptest.NewRequest("POST", "http://local/path?query", nil)
 
 	expectedFields := map[string]interface{}{
-		"key":                "value",
-		logger.FieldErrorKey: "", // value will not be checked, only the key
+		"key":                    "value",
+		logger.FieldErrorKey:     "", // value will not be checked, only the key
+		logger.FieldErrorTypeKey: "",
 	}
 	handler := func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -119,3 +120,17 @@ func Test_defaultLogAtLevelFunc(t *testing.T) {
 		})
 	}
 }
+
+func Test_Middleware_loggerInContext(t *testing.T) {
+	log := logger.NewInMemory(logger.LevelDebug)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://local/path", nil)
+
+	New(log)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		logger.InfoContext(r.Context(), "from handler")
+	})).ServeHTTP(w, r)
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, []interface{}{"from handler"}, log.Entries[0].Args)
+}
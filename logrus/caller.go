@@ -0,0 +1,60 @@
+package logrus
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/krostar/logger"
+)
+
+// callerHook replaces the caller found by logrus, which
+// only skips logrus frames, by the real caller of the entry.
+// The caller is removed if it can't be found, for entries
+// written asynchronously for instance.
+type callerHook struct{}
+
+// Levels implements logrus.Hook for callerHook.
+func (callerHook) Levels() []logrus.Level { return logrus.AllLevels }
+
+// Fire implements logrus.Hook for callerHook.
+func (callerHook) Fire(entry *logrus.Entry) error {
+	if frame, ok := logger.CallerFrame(); ok {
+		entry.Caller = &frame
+	} else {
+		entry.Caller = nil
+	}
+	return nil
+}
+
+// stacktraceHook adds the stack trace of the entries at or above a level.
+type stacktraceHook struct {
+	level logrus.Level
+}
+
+// Levels implements logrus.Hook for stacktraceHook.
+func (h stacktraceHook) Levels() []logrus.Level {
+	var levels []logrus.Level
+	for _, lvl := range logrus.AllLevels {
+		if lvl <= h.level {
+			levels = append(levels, lvl)
+		}
+	}
+	return levels
+}
+
+// Fire implements logrus.Hook for stacktraceHook.
+func (stacktraceHook) Fire(entry *logrus.Entry) error {
+	stack := logger.Stacktrace()
+	if stack == "" {
+		return nil
+	}
+
+	// the entry data are shared with the logger the entry comes from
+	data := make(logrus.Fields, len(entry.Data)+1)
+	for key, value := range entry.Data {
+		data[key] = value
+	}
+	data[logger.FieldStacktraceKey] = stack
+	entry.Data = data
+
+	return nil
+}
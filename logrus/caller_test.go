@@ -0,0 +1,58 @@
+package logrus
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func TestLogrus_caller(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := newDeterministicLogger()
+		require.NoError(t, WithCaller()(&options{log: log.log}))
+		require.NoError(t, WithStacktrace(logger.LevelError)(&options{log: log.log}))
+
+		// the caller must be found through any number of wrappers
+		child := logger.NewSampler(logger.NewRedactor(log)).WithField("hello", "world")
+		child.Error("error")
+		child.Warn("warn")
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(outputRaw), "\n")
+	require.Len(t, lines, 2)
+
+	var errorOutput, warn map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &errorOutput))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &warn))
+
+	assert.Contains(t, warn["file"], "logrus/caller_test.go:")
+	assert.Equal(t, "github.com/krostar/logger/logrus.TestLogrus_caller.func1", warn["func"])
+	assert.NotContains(t, warn, logger.FieldStacktraceKey, "stacktrace should not leak to the next entries")
+
+	require.Contains(t, errorOutput, logger.FieldStacktraceKey)
+	assert.True(t, strings.HasPrefix(errorOutput[logger.FieldStacktraceKey].(string), "github.com/krostar/logger/logrus.TestLogrus_caller.func1\n"))
+}
+
+func TestLogrus_caller_async(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := newDeterministicLogger()
+		require.NoError(t, WithCaller()(&options{log: log.log}))
+
+		async := logger.NewAsync(log)
+		async.Info("info")
+		require.NoError(t, async.Close(context.Background()))
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.NotContains(t, output, "file", "the caller can't be found from the background goroutine")
+	assert.NotContains(t, output, "func")
+}
@@ -0,0 +1,36 @@
+package logrus
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/krostar/logger"
+)
+
+// hook adapts a logger.Hook to a logrus.Hook.
+type hook struct {
+	hook logger.Hook
+}
+
+// Levels implements logrus.Hook for hook.
+func (hook) Levels() []logrus.Level { return logrus.AllLevels }
+
+// Fire implements logrus.Hook for hook.
+func (h hook) Fire(entry *logrus.Entry) error {
+	fields := make(map[string]interface{}, len(entry.Data))
+	for key, value := range entry.Data {
+		fields[key] = resolveValue(value)
+	}
+
+	level := convertLogrusLevel(entry.Level)
+	if level == logger.LevelQuiet { // fatal and panic entries
+		level = logger.LevelError
+	}
+
+	h.hook.Fire(logger.HookEntry{
+		Time:    entry.Time,
+		Level:   level,
+		Message: entry.Message,
+		Fields:  fields,
+	})
+	return nil
+}
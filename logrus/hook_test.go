@@ -0,0 +1,47 @@
+package logrus
+
+import (
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func Test_WithHooks(t *testing.T) {
+	var entries []logger.HookEntry
+
+	log, err := New(WithHooks(logger.HookFunc(func(entry logger.HookEntry) {
+		entries = append(entries, entry)
+	})))
+	require.NoError(t, err)
+	log.log.Out = io.Discard
+
+	child := log.Named("db").WithField("lazy", logger.Lazy(func() interface{} { return 42 }))
+	child.Debug("debug")
+	child.Warnf("warn %d", 42)
+	child.Errorw("error", "hello", "world")
+
+	require.Len(t, entries, 2)
+	assert.False(t, entries[0].Time.IsZero())
+	assert.Equal(t, logger.LevelWarn, entries[0].Level)
+	assert.Equal(t, "warn 42", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{"lazy": 42, logger.FieldNameKey: "db"}, entries[0].Fields)
+	assert.Equal(t, logger.LevelError, entries[1].Level)
+	assert.Equal(t, "error", entries[1].Message)
+	assert.Equal(t, map[string]interface{}{"lazy": 42, "hello": "world", logger.FieldNameKey: "db"}, entries[1].Fields)
+
+	child.WithField("obj", logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+		enc.AddString("hello", "world")
+		return nil
+	})).Error("object")
+	require.Len(t, entries, 3)
+	assert.Equal(t, map[string]interface{}{"hello": "world"}, entries[2].Fields["obj"])
+
+	require.NoError(t, log.log.Hooks[logrus.PanicLevel][0].Fire(&logrus.Entry{Level: logrus.PanicLevel}))
+	require.Len(t, entries, 4)
+	assert.Equal(t, logger.LevelError, entries[3].Level, "levels above error are fired as errors")
+}
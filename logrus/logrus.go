@@ -15,6 +15,7 @@ import (
 type Logrus struct {
 	log *logrus.Logger
 	logrus.FieldLogger
+	name string
 }
 
 // New returns a new logrus instance.
@@ -57,6 +58,23 @@ func convertLevel(level logger.Level) (logrus.Level, error) {
 	return logrusLevel, nil
 }
 
+// convertLogrusLevel returns the level of the logrus level,
+// levels above error are considered as quiet.
+func convertLogrusLevel(level logrus.Level) logger.Level {
+	switch {
+	case level >= logrus.DebugLevel:
+		return logger.LevelDebug
+	case level == logrus.InfoLevel:
+		return logger.LevelInfo
+	case level == logrus.WarnLevel:
+		return logger.LevelWarn
+	case level == logrus.ErrorLevel:
+		return logger.LevelError
+	default:
+		return logger.LevelQuiet
+	}
+}
+
 // SetLevel applies a new level to a logger instance.
 func (l *Logrus) SetLevel(level logger.Level) error {
 	lvl, err := convertLevel(level)
@@ -67,11 +85,120 @@ func (l *Logrus) SetLevel(level logger.Level) error {
 	return nil
 }
 
+// Debug implements Logger.Debug for logrus's logger.
+func (l *Logrus) Debug(args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.DebugLevel) {
+		l.FieldLogger.Debug(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Debugf implements Logger.Debugf for logrus's logger.
+func (l *Logrus) Debugf(format string, args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.DebugLevel) {
+		l.FieldLogger.Debugf(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Info implements Logger.Info for logrus's logger.
+func (l *Logrus) Info(args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.InfoLevel) {
+		l.FieldLogger.Info(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Infof implements Logger.Infof for logrus's logger.
+func (l *Logrus) Infof(format string, args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.InfoLevel) {
+		l.FieldLogger.Infof(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Warn implements Logger.Warn for logrus's logger.
+func (l *Logrus) Warn(args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.WarnLevel) {
+		l.FieldLogger.Warn(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Warnf implements Logger.Warnf for logrus's logger.
+func (l *Logrus) Warnf(format string, args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.WarnLevel) {
+		l.FieldLogger.Warnf(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Error implements Logger.Error for logrus's logger.
+func (l *Logrus) Error(args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.ErrorLevel) {
+		l.FieldLogger.Error(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Errorf implements Logger.Errorf for logrus's logger.
+func (l *Logrus) Errorf(format string, args ...interface{}) {
+	if l.log.IsLevelEnabled(logrus.ErrorLevel) {
+		l.FieldLogger.Errorf(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Debugw implements Logger.Debugw for logrus's logger.
+func (l *Logrus) Debugw(msg string, keysAndValues ...interface{}) {
+	l.logw(logrus.DebugLevel, msg, keysAndValues)
+}
+
+// Infow implements Logger.Infow for logrus's logger.
+func (l *Logrus) Infow(msg string, keysAndValues ...interface{}) {
+	l.logw(logrus.InfoLevel, msg, keysAndValues)
+}
+
+// Warnw implements Logger.Warnw for logrus's logger.
+func (l *Logrus) Warnw(msg string, keysAndValues ...interface{}) {
+	l.logw(logrus.WarnLevel, msg, keysAndValues)
+}
+
+// Errorw implements Logger.Errorw for logrus's logger.
+func (l *Logrus) Errorw(msg string, keysAndValues ...interface{}) {
+	l.logw(logrus.ErrorLevel, msg, keysAndValues)
+}
+
+func (l *Logrus) logw(lvl logrus.Level, msg string, keysAndValues []interface{}) {
+	if !l.log.IsLevelEnabled(lvl) {
+		return
+	}
+
+	entry := l.FieldLogger.WithFields(convertFields(logger.KeysAndValuesToFields(logger.ResolveLazyArgs(keysAndValues))))
+	switch lvl {
+	case logrus.DebugLevel:
+		entry.Debug(msg)
+	case logrus.InfoLevel:
+		entry.Info(msg)
+	case logrus.WarnLevel:
+		entry.Warn(msg)
+	default:
+		entry.Error(msg)
+	}
+}
+
+// Level implements Logger.Level for logrus's logger.
+func (l *Logrus) Level() logger.Level {
+	return convertLogrusLevel(l.log.GetLevel())
+}
+
+// Enabled implements Logger.Enabled for logrus's logger.
+func (l *Logrus) Enabled(level logger.Level) bool {
+	lvl, err := convertLevel(level)
+	if err != nil {
+		return false
+	}
+	return l.log.IsLevelEnabled(lvl)
+}
+
 // WithField implements Logger.WithField for logrus's logger.
 func (l *Logrus) WithField(key string, value interface{}) logger.Logger {
 	return &Logrus{
 		log:         l.log,
-		FieldLogger: l.FieldLogger.WithField(key, value),
+		FieldLogger: l.FieldLogger.WithField(key, convertValue(value)),
+		name:        l.name,
 	}
 }
 
@@ -79,14 +206,31 @@ func (l *Logrus) WithField(key string, value interface{}) logger.Logger {
 func (l *Logrus) WithFields(fields map[string]interface{}) logger.Logger {
 	return &Logrus{
 		log:         l.log,
-		FieldLogger: l.FieldLogger.WithFields(fields),
+		FieldLogger: l.FieldLogger.WithFields(convertFields(fields)),
+		name:        l.name,
 	}
 }
 
+// With implements Logger.With for logrus's logger.
+func (l *Logrus) With(fields ...logger.Field) logger.Logger {
+	return l.WithFields(logger.FieldsToMap(logger.ExpandErrorFields(fields)))
+}
+
 // WithError implements Logger.WithError for logrus's logger.
 func (l *Logrus) WithError(err error) logger.Logger {
 	if err != nil {
-		return l.WithField(logger.FieldErrorKey, err.Error())
+		return l.With(logger.ErrorFields(err)...)
 	}
 	return l
 }
+
+// Named implements Logger.Named for logrus's logger.
+// The name is stored in the logger.FieldNameKey field.
+func (l *Logrus) Named(name string) logger.Logger {
+	name = logger.JoinNames(l.name, name)
+	return &Logrus{
+		log:         l.log,
+		FieldLogger: l.FieldLogger.WithField(logger.FieldNameKey, name),
+		name:        name,
+	}
+}
@@ -79,6 +79,21 @@ func Test_convertLevel(t *testing.T) {
 	}
 }
 
+func Test_convertLogrusLevel(t *testing.T) {
+	tests := map[logrus.Level]logger.Level{
+		logrus.TraceLevel: logger.LevelDebug,
+		logrus.DebugLevel: logger.LevelDebug,
+		logrus.InfoLevel:  logger.LevelInfo,
+		logrus.WarnLevel:  logger.LevelWarn,
+		logrus.ErrorLevel: logger.LevelError,
+		logrus.FatalLevel: logger.LevelQuiet,
+		logrus.PanicLevel: logger.LevelQuiet,
+	}
+	for logrusLevel, expectedLevel := range tests {
+		assert.Equal(t, expectedLevel, convertLogrusLevel(logrusLevel), "logrus level %s", logrusLevel)
+	}
+}
+
 func Test_RedirectStdLog(t *testing.T) {
 	const imalog = "imalog"
 
@@ -166,12 +181,102 @@ func TestLogrus_WithFields(t *testing.T) {
 	}, output)
 }
 
+func TestLogrus_Named(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := newDeterministicLogger()
+		log.Named("db").WithField("hello", "world").Named("pool").Warn("warn")
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":             logrus.WarnLevel.String(),
+		"msg":               "warn",
+		"hello":             "world",
+		logger.FieldNameKey: "db.pool",
+	}, output)
+}
+
 func TestLogrus_WithError(t *testing.T) {
 	outputRaw, err := logger.CaptureOutput(func() {
 		log := newDeterministicLogger()
 		log.
 			WithError(errors.New("eww1")).
-			WithError(errors.New("eww2")).
+			WithError(fmt.Errorf("eww2: %w", errors.New("cause"))).
+			Warn("warn")
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":                   logrus.WarnLevel.String(),
+		"msg":                     "warn",
+		logger.FieldErrorKey:      "eww2: cause",
+		logger.FieldErrorTypeKey:  "*fmt.wrapError",
+		logger.FieldErrorChainKey: []interface{}{"cause"},
+	}, output)
+}
+
+func TestLogrus_Logw(t *testing.T) {
+	tests := map[string]struct {
+		logWFunc      func(log *Logrus) func(msg string, keysAndValues ...interface{})
+		expectedLevel logrus.Level
+	}{
+		"debug": {
+			logWFunc:      func(log *Logrus) func(string, ...interface{}) { return log.Debugw },
+			expectedLevel: logrus.DebugLevel,
+		}, "info": {
+			logWFunc:      func(log *Logrus) func(string, ...interface{}) { return log.Infow },
+			expectedLevel: logrus.InfoLevel,
+		}, "warn": {
+			logWFunc:      func(log *Logrus) func(string, ...interface{}) { return log.Warnw },
+			expectedLevel: logrus.WarnLevel,
+		}, "error": {
+			logWFunc:      func(log *Logrus) func(string, ...interface{}) { return log.Errorw },
+			expectedLevel: logrus.ErrorLevel,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			outputRaw, err := logger.CaptureOutput(func() {
+				log := newDeterministicLogger()
+				_ = log.SetLevel(logger.LevelDebug)
+				test.logWFunc(log)("msg", "hello", "world", "answer", 42)
+			})
+			require.NoError(t, err)
+
+			var output map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+			assert.Equal(t, map[string]interface{}{
+				"level":  test.expectedLevel.String(),
+				"msg":    "msg",
+				"hello":  "world",
+				"answer": float64(42),
+			}, output)
+		})
+	}
+
+	t.Run("disabled level", func(t *testing.T) {
+		outputRaw, err := logger.CaptureOutput(func() {
+			log := newDeterministicLogger()
+			log.Debugw("msg", "hello", "world")
+		})
+		require.NoError(t, err)
+		assert.Empty(t, outputRaw)
+	})
+}
+
+func TestLogrus_With(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := newDeterministicLogger()
+		log.
+			With(logger.String("hello", "world"), logger.Int("answer", 42)).
+			With(logger.Bool("ok", true), logger.Err(nil)).
+			With(logger.Err(fmt.Errorf("eww: %w", errors.New("cause")))).
 			Warn("warn")
 	})
 	require.NoError(t, err)
@@ -179,8 +284,142 @@ func TestLogrus_WithError(t *testing.T) {
 	var output map[string]interface{}
 	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
 	assert.Equal(t, map[string]interface{}{
-		"level":              logrus.WarnLevel.String(),
-		"msg":                "warn",
-		logger.FieldErrorKey: "eww2",
+		"level":                   logrus.WarnLevel.String(),
+		"msg":                     "warn",
+		"hello":                   "world",
+		"answer":                  float64(42),
+		"ok":                      true,
+		logger.FieldErrorKey:      "eww: cause",
+		logger.FieldErrorTypeKey:  "*fmt.wrapError",
+		logger.FieldErrorChainKey: []interface{}{"cause"},
+	}, output)
+}
+
+type user struct {
+	id       int64
+	password string
+}
+
+func (u user) MarshalLogObject(enc logger.ObjectEncoder) error {
+	enc.AddInt64("id", u.id)
+	return enc.AddObject("address", logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+		return enc.AddAny("city", "Paris")
+	}))
+}
+
+func TestLogrus_object(t *testing.T) {
+	expectedUser := map[string]interface{}{
+		"id":      float64(42),
+		"address": map[string]interface{}{"city": "Paris"},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		outputRaw, err := logger.CaptureOutput(func() {
+			log := newDeterministicLogger()
+			u := user{id: 42, password: "secret"}
+			log.
+				WithField("field", u).
+				WithFields(map[string]interface{}{"fields": u}).
+				With(logger.Object("typed", u)).
+				WithField("lazy", logger.Lazy(func() interface{} { return u })).
+				Warnw("warn", "kv", u)
+		})
+		require.NoError(t, err)
+
+		var output map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+		assert.Equal(t, map[string]interface{}{
+			"level":  logrus.WarnLevel.String(),
+			"msg":    "warn",
+			"field":  expectedUser,
+			"fields": expectedUser,
+			"typed":  expectedUser,
+			"lazy":   expectedUser,
+			"kv":     expectedUser,
+		}, output)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		outputRaw, err := logger.CaptureOutput(func() {
+			log := newDeterministicLogger()
+			log.log.Formatter = &logrus.TextFormatter{DisableTimestamp: true, DisableColors: true}
+			log.WithField("user", user{id: 42, password: "secret"}).Warn("warn")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "level=warning msg=warn user=\"address={city=Paris} id=42\"\n", outputRaw)
+	})
+
+	t.Run("marshaled when formatted", func(t *testing.T) {
+		var calls int
+		obj := logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+			calls++
+			enc.AddString("hello", "world")
+			return nil
+		})
+
+		outputRaw, err := logger.CaptureOutput(func() {
+			log := newDeterministicLogger()
+			require.NoError(t, log.SetLevel(logger.LevelWarn))
+			child := log.WithField("obj", obj)
+			child.Info("info")
+			assert.Zero(t, calls, "object should not be marshaled if the entry is not written")
+			child.Warn("warn")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Contains(t, outputRaw, `"obj":{"hello":"world"}`)
+	})
+}
+
+func TestLogrus_Enabled(t *testing.T) {
+	log, _ := New(WithLevel(logger.LevelWarn))
+
+	assert.Equal(t, logger.LevelWarn, log.Level())
+	assert.False(t, log.Enabled(logger.LevelInfo))
+	assert.True(t, log.Enabled(logger.LevelWarn))
+	assert.True(t, log.Enabled(logger.LevelError))
+	assert.False(t, log.Enabled(logger.LevelQuiet))
+
+	tests := map[logrus.Level]logger.Level{
+		logrus.TraceLevel: logger.LevelDebug,
+		logrus.DebugLevel: logger.LevelDebug,
+		logrus.InfoLevel:  logger.LevelInfo,
+		logrus.WarnLevel:  logger.LevelWarn,
+		logrus.ErrorLevel: logger.LevelError,
+		logrus.FatalLevel: logger.LevelQuiet,
+	}
+	for logrusLevel, expectedLevel := range tests {
+		log.log.SetLevel(logrusLevel)
+		assert.Equal(t, expectedLevel, log.Level(), "logrus level %s", logrusLevel)
+	}
+}
+
+func TestLogrus_lazy(t *testing.T) {
+	var calls int
+	lazy := logger.Lazy(func() interface{} {
+		calls++
+		return map[string]int{"answer": 42}
+	})
+
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := newDeterministicLogger()
+		child := log.WithField("lazy", lazy)
+
+		child.Debug("debug")
+		child.Debugf("debug %v", lazy)
+		child.Debugw("debug", "lazy", lazy)
+		require.Equal(t, 0, calls)
+
+		child.Warnf("warn %v", lazy)
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level": logrus.WarnLevel.String(),
+		"msg":   "warn map[answer:42]",
+		"lazy":  map[string]interface{}{"answer": float64(42)},
 	}, output)
+	assert.Equal(t, 1, calls)
 }
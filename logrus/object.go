@@ -0,0 +1,101 @@
+package logrus
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/krostar/logger"
+)
+
+// objectValue adapts a logger.ObjectMarshaler to the interfaces used by logrus
+// formatters, which would otherwise use reflection. The object is only
+// marshaled when the entry is formatted.
+type objectValue struct {
+	obj logger.ObjectMarshaler
+}
+
+// MarshalJSON implements json.Marshaler for objectValue, used by the JSON formatter.
+func (v objectValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(logger.MarshalObject(v.obj))
+}
+
+// String implements fmt.Stringer for objectValue, used by the text formatter.
+// Fields are rendered as key=value pairs sorted by key, nested objects in braces.
+func (v objectValue) String() string {
+	var b strings.Builder
+	writeObject(&b, logger.MarshalObject(v.obj))
+	return b.String()
+}
+
+func writeObject(b *strings.Builder, fields map[string]interface{}) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(key)
+		b.WriteByte('=')
+
+		switch value := fields[key].(type) {
+		case map[string]interface{}:
+			b.WriteByte('{')
+			writeObject(b, value)
+			b.WriteByte('}')
+		case string:
+			if strings.ContainsAny(value, " ={}\"") {
+				value = strconv.Quote(value)
+			}
+			b.WriteString(value)
+		default:
+			fmt.Fprint(b, value)
+		}
+	}
+}
+
+// convertValue returns the value logrus should format for v.
+func convertValue(v interface{}) interface{} {
+	if obj, ok := v.(logger.ObjectMarshaler); ok {
+		return objectValue{obj: obj}
+	}
+	return v
+}
+
+// convertFields returns the fields with objects adapted for logrus.
+// The provided map is returned as is if it does not contain any object.
+func convertFields(fields map[string]interface{}) map[string]interface{} {
+	var converted map[string]interface{}
+
+	for key, value := range fields {
+		if _, ok := value.(logger.ObjectMarshaler); !ok {
+			continue
+		}
+		if converted == nil {
+			converted = make(map[string]interface{}, len(fields))
+			for k, v := range fields {
+				converted[k] = v
+			}
+		}
+		converted[key] = convertValue(value)
+	}
+
+	if converted == nil {
+		return fields
+	}
+	return converted
+}
+
+// resolveValue returns the value given to hooks for the field value v.
+func resolveValue(v interface{}) interface{} {
+	if value, ok := v.(objectValue); ok {
+		return logger.MarshalObject(value.obj)
+	}
+	return logger.ResolveObject(logger.ResolveLazy(v))
+}
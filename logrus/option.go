@@ -46,6 +46,20 @@ func WithConfig(cfg logger.Config) Option {
 	// outputs
 	opts = append(opts, withOutputStr(cfg.Output))
 
+	// caller and stacktrace
+	if cfg.WithCaller {
+		opts = append(opts, WithCaller())
+	}
+	if cfg.Stacktrace != "" {
+		if lvl, err := logger.ParseLevel(cfg.Stacktrace); err == nil {
+			opts = append(opts, WithStacktrace(lvl))
+		} else {
+			return func(c *options) error {
+				return fmt.Errorf("unable to apply stacktrace level %q, %w", cfg.Stacktrace, err)
+			}
+		}
+	}
+
 	// return all options
 	return func(c *options) error {
 		for _, opt := range opts {
@@ -155,3 +169,37 @@ func WithoutTime() Option {
 		return nil
 	}
 }
+
+// WithCaller configures the logger to report the file, line and function
+// of the code that logged each entry. The caller is the first function
+// outside of the logging packages, see logger.CallerFrame.
+func WithCaller() Option {
+	return func(o *options) error {
+		o.log.ReportCaller = true
+		o.log.AddHook(callerHook{})
+		return nil
+	}
+}
+
+// WithHooks registers hooks fired with each written entry.
+func WithHooks(hooks ...logger.Hook) Option {
+	return func(o *options) error {
+		for _, h := range hooks {
+			o.log.AddHook(hook{hook: h})
+		}
+		return nil
+	}
+}
+
+// WithStacktrace configures the logger to add the stack trace of the code
+// that logged each entry at or above the provided level.
+func WithStacktrace(level logger.Level) Option {
+	return func(o *options) error {
+		lvl, err := convertLevel(level)
+		if err != nil {
+			return fmt.Errorf("failed to convert level: %w", err)
+		}
+		o.log.AddHook(stacktraceHook{level: lvl})
+		return nil
+	}
+}
@@ -40,6 +40,22 @@ func Test_WithConfig_console(t *testing.T) {
 	assert.IsType(t, new(logrus.TextFormatter), o.log.Formatter)
 }
 
+func Test_WithConfig_caller(t *testing.T) {
+	o := options{log: logrus.New()}
+
+	err := WithConfig(logger.Config{
+		Verbosity:  "error",
+		Formatter:  "json",
+		WithCaller: true,
+		Stacktrace: "error",
+	})(&o)
+	require.NoError(t, err)
+
+	assert.True(t, o.log.ReportCaller)
+	assert.Len(t, o.log.Hooks[logrus.ErrorLevel], 2)
+	assert.Len(t, o.log.Hooks[logrus.WarnLevel], 1)
+}
+
 func Test_WithConfig_error(t *testing.T) {
 	t.Run("unparsable level", func(t *testing.T) {
 		o := options{log: logrus.New()}
@@ -51,6 +67,17 @@ func Test_WithConfig_error(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("unparsable stacktrace level", func(t *testing.T) {
+		o := options{log: logrus.New()}
+
+		err := WithConfig(logger.Config{
+			Verbosity:  "error",
+			Formatter:  "json",
+			Stacktrace: "boum",
+		})(&o)
+		require.Error(t, err)
+	})
+
 	t.Run("unknown format", func(t *testing.T) {
 		o := options{log: logrus.New()}
 
@@ -176,3 +203,21 @@ func Test_WithoutTime(t *testing.T) {
 		require.Error(t, WithoutTime()(&o))
 	})
 }
+
+func Test_WithCaller(t *testing.T) {
+	o := options{log: logrus.New()}
+
+	require.NoError(t, WithCaller()(&o))
+	assert.True(t, o.log.ReportCaller)
+	assert.Len(t, o.log.Hooks[logrus.InfoLevel], 1)
+}
+
+func Test_WithStacktrace(t *testing.T) {
+	o := options{log: logrus.New()}
+
+	require.Error(t, WithStacktrace(logger.Level(42))(&o))
+	require.NoError(t, WithStacktrace(logger.LevelWarn)(&o))
+	assert.Len(t, o.log.Hooks[logrus.ErrorLevel], 1)
+	assert.Len(t, o.log.Hooks[logrus.WarnLevel], 1)
+	assert.Empty(t, o.log.Hooks[logrus.InfoLevel])
+}
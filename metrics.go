@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Metrics is a logger counting, per logger name and per level, the entries
+// that are written and the ones that are dropped as their level is disabled.
+// Counts can be exposed through expvar or Prometheus, see the logmetrics package.
+type Metrics struct {
+	Logger
+
+	lock     sync.RWMutex
+	counters map[metricsKey]*metricsCounter
+}
+
+// EntriesCount is the number of entries of a level logged by a logger.
+type EntriesCount struct {
+	Name    string
+	Level   Level
+	Emitted uint64
+	Dropped uint64
+}
+
+type metricsKey struct {
+	name  string
+	level Level
+}
+
+type metricsCounter struct {
+	emitted atomic.Uint64
+	dropped atomic.Uint64
+}
+
+// NewMetrics returns a logger that counts the entries logged through it
+// and through all the loggers derived from it.
+func NewMetrics(l Logger) *Metrics {
+	m := &Metrics{counters: make(map[metricsKey]*metricsCounter)}
+	m.Logger = m.wrap("")(l)
+	return m
+}
+
+// Counts returns the number of entries logged so far,
+// sorted by logger name and by level.
+// The empty name designates the root logger.
+func (m *Metrics) Counts() []EntriesCount {
+	m.lock.RLock()
+	counts := make([]EntriesCount, 0, len(m.counters))
+	for key, counter := range m.counters {
+		counts = append(counts, EntriesCount{
+			Name:    key.name,
+			Level:   key.level,
+			Emitted: counter.emitted.Load(),
+			Dropped: counter.dropped.Load(),
+		})
+	}
+	m.lock.RUnlock()
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Name != counts[j].Name {
+			return counts[i].Name < counts[j].Name
+		}
+		return counts[i].Level < counts[j].Level
+	})
+	return counts
+}
+
+func (m *Metrics) counter(name string, lvl Level) *metricsCounter {
+	key := metricsKey{name: name, level: lvl}
+
+	m.lock.RLock()
+	counter, ok := m.counters[key]
+	m.lock.RUnlock()
+	if ok {
+		return counter
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if counter, ok = m.counters[key]; !ok {
+		counter = new(metricsCounter)
+		m.counters[key] = counter
+	}
+	return counter
+}
+
+func (m *Metrics) wrap(name string) func(Logger) Logger {
+	var wrap func(Logger) Logger
+	wrap = func(l Logger) Logger {
+		n := &metricsNode{metrics: m, name: name}
+		n.wrapper = &wrapper{Logger: l, handle: n.handle, wrap: wrap}
+		return n
+	}
+	return wrap
+}
+
+// metricsNode is a logger derived from the metrics, bound to a name.
+type metricsNode struct {
+	*wrapper
+	metrics *Metrics
+	name    string
+}
+
+// Named implements Logger for metricsNode.
+func (n *metricsNode) Named(name string) Logger {
+	return n.metrics.wrap(JoinNames(n.name, name))(n.wrapper.Logger.Named(name))
+}
+
+func (n *metricsNode) handle(next Logger, e entry) {
+	counter := n.metrics.counter(n.name, e.level)
+	if !next.Enabled(e.level) {
+		counter.dropped.Add(1)
+		return
+	}
+	counter.emitted.Add(1)
+	e.writeTo(next)
+}
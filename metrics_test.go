@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewMetrics(t *testing.T) {
+	backend := NewInMemory(LevelInfo)
+	metrics := NewMetrics(backend)
+
+	metrics.Debug("debug")
+	metrics.Infof("info %d", 42)
+	metrics.WithField("hello", "world").Errorw("error", "answer", 42)
+
+	db := metrics.Named("db")
+	db.Warn("warn")
+	db.Named("pool").WithError(nil).Warn("warn")
+	db.Named("pool").Debug("debug")
+
+	require.Len(t, backend.Entries, 4)
+	assert.Equal(t, []EntriesCount{
+		{Name: "", Level: LevelDebug, Dropped: 1},
+		{Name: "", Level: LevelInfo, Emitted: 1},
+		{Name: "", Level: LevelError, Emitted: 1},
+		{Name: "db", Level: LevelWarn, Emitted: 1},
+		{Name: "db.pool", Level: LevelDebug, Dropped: 1},
+		{Name: "db.pool", Level: LevelWarn, Emitted: 1},
+	}, metrics.Counts())
+}
+
+func Test_NewMetrics_concurrency(t *testing.T) {
+	metrics := NewMetrics(new(Noop))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				metrics.Named("db").Error("error")
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []EntriesCount{{Name: "db", Level: LevelError, Dropped: 1000}}, metrics.Counts())
+}
@@ -0,0 +1,144 @@
+package logger
+
+import (
+	"errors"
+)
+
+// Multi returns a logger that forwards every call to all the provided loggers.
+// Each logger keeps its own minimum level, but SetLevel applies to all of them.
+func Multi(loggers ...Logger) Logger {
+	return multi(loggers)
+}
+
+type multi []Logger
+
+// SetLevel implements Logger for multi.
+func (m multi) SetLevel(lvl Level) error {
+	var errs []error
+	for _, l := range m {
+		if err := l.SetLevel(lvl); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Level implements Logger for multi, it returns the lowest level of all loggers.
+func (m multi) Level() Level {
+	lowest := LevelQuiet
+	for _, l := range m {
+		if lvl := l.Level(); lvl < lowest {
+			lowest = lvl
+		}
+	}
+	return lowest
+}
+
+// Enabled implements Logger for multi, it returns true if at least one logger is enabled.
+func (m multi) Enabled(lvl Level) bool {
+	for _, l := range m {
+		if l.Enabled(lvl) {
+			return true
+		}
+	}
+	return false
+}
+
+// Debug implements Logger for multi.
+func (m multi) Debug(args ...interface{}) {
+	m.log(entry{level: LevelDebug, kind: entryKindPrint, args: args})
+}
+
+// Debugf implements Logger for multi.
+func (m multi) Debugf(format string, args ...interface{}) {
+	m.log(entry{level: LevelDebug, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Debugw implements Logger for multi.
+func (m multi) Debugw(msg string, keysAndValues ...interface{}) {
+	m.log(entry{level: LevelDebug, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// Info implements Logger for multi.
+func (m multi) Info(args ...interface{}) {
+	m.log(entry{level: LevelInfo, kind: entryKindPrint, args: args})
+}
+
+// Infof implements Logger for multi.
+func (m multi) Infof(format string, args ...interface{}) {
+	m.log(entry{level: LevelInfo, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Infow implements Logger for multi.
+func (m multi) Infow(msg string, keysAndValues ...interface{}) {
+	m.log(entry{level: LevelInfo, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// Warn implements Logger for multi.
+func (m multi) Warn(args ...interface{}) {
+	m.log(entry{level: LevelWarn, kind: entryKindPrint, args: args})
+}
+
+// Warnf implements Logger for multi.
+func (m multi) Warnf(format string, args ...interface{}) {
+	m.log(entry{level: LevelWarn, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Warnw implements Logger for multi.
+func (m multi) Warnw(msg string, keysAndValues ...interface{}) {
+	m.log(entry{level: LevelWarn, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// Error implements Logger for multi.
+func (m multi) Error(args ...interface{}) {
+	m.log(entry{level: LevelError, kind: entryKindPrint, args: args})
+}
+
+// Errorf implements Logger for multi.
+func (m multi) Errorf(format string, args ...interface{}) {
+	m.log(entry{level: LevelError, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Errorw implements Logger for multi.
+func (m multi) Errorw(msg string, keysAndValues ...interface{}) {
+	m.log(entry{level: LevelError, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// WithField implements Logger for multi.
+func (m multi) WithField(key string, value interface{}) Logger {
+	return m.derive(func(l Logger) Logger { return l.WithField(key, value) })
+}
+
+// WithFields implements Logger for multi.
+func (m multi) WithFields(fields map[string]interface{}) Logger {
+	return m.derive(func(l Logger) Logger { return l.WithFields(fields) })
+}
+
+// With implements Logger for multi.
+func (m multi) With(fields ...Field) Logger {
+	return m.derive(func(l Logger) Logger { return l.With(fields...) })
+}
+
+// WithError implements Logger for multi.
+func (m multi) WithError(err error) Logger {
+	return m.derive(func(l Logger) Logger { return l.WithError(err) })
+}
+
+// Named implements Logger for multi.
+func (m multi) Named(name string) Logger {
+	return m.derive(func(l Logger) Logger { return l.Named(name) })
+}
+
+func (m multi) log(e entry) {
+	for _, l := range m {
+		e.writeTo(l)
+	}
+}
+
+func (m multi) derive(fct func(Logger) Logger) Logger {
+	children := make(multi, len(m))
+	for i, l := range m {
+		children[i] = fct(l)
+	}
+	return children
+}
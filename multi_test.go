@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MultiImplementLogger(t *testing.T) {
+	var i interface{} = Multi()
+	if _, ok := i.(Logger); !ok {
+		t.Fatalf("expected %t to implement Logger", i)
+	}
+}
+
+func Test_Multi_log(t *testing.T) {
+	var (
+		debug = NewInMemory(LevelDebug)
+		warn  = NewInMemory(LevelWarn)
+		log   = Multi(debug, warn)
+	)
+
+	log.Debug("debug")
+	log.Infof("info %d", 42)
+	log.Warnw("warn", "answer", 42)
+	log.Error("error")
+	log.Errorf("error %d", 42)
+	log.Errorw("error")
+
+	require.Len(t, debug.Entries, 6)
+	require.Len(t, warn.Entries, 4)
+	assert.Equal(t, LevelWarn, warn.Entries[0].Level)
+	assert.Equal(t, map[string]interface{}{"answer": 42}, warn.Entries[0].Fields)
+}
+
+func Test_Multi_lazy(t *testing.T) {
+	var (
+		first  = NewInMemory(LevelDebug)
+		second = NewInMemory(LevelDebug)
+		calls  int
+	)
+
+	Multi(first, second).Info(Lazy(func() interface{} {
+		calls++
+		return "lazy"
+	}))
+
+	require.Len(t, first.Entries, 1)
+	require.Len(t, second.Entries, 1)
+	assert.Equal(t, []interface{}{"lazy"}, second.Entries[0].Args)
+	assert.Equal(t, 1, calls)
+}
+
+func Test_Multi_fields(t *testing.T) {
+	var (
+		first  = NewInMemory(LevelDebug)
+		second = NewInMemory(LevelDebug)
+		log    = Multi(first, second)
+	)
+
+	log.
+		WithField("a", 1).
+		WithFields(map[string]interface{}{"b": 2}).
+		With(Int("c", 3)).
+		WithError(errors.New("eww")).
+		Named("name").
+		Info("info")
+
+	for _, l := range []*InMemory{first, second} {
+		require.Len(t, l.Entries, 1)
+		assert.Equal(t, 1, l.Entries[0].Fields["a"])
+		assert.Equal(t, 2, l.Entries[0].Fields["b"])
+		assert.Equal(t, int64(3), l.Entries[0].Fields["c"])
+		assert.Contains(t, l.Entries[0].Fields, FieldErrorKey)
+		assert.Equal(t, "name", l.Entries[0].Fields[FieldNameKey])
+	}
+}
+
+func Test_Multi_level(t *testing.T) {
+	var (
+		info = NewInMemory(LevelInfo)
+		errs = NewInMemory(LevelError)
+		log  = Multi(info, errs)
+	)
+
+	assert.Equal(t, LevelInfo, log.Level())
+	assert.True(t, log.Enabled(LevelInfo))
+	assert.False(t, log.Enabled(LevelDebug))
+	assert.Equal(t, LevelQuiet, Multi().Level())
+
+	require.NoError(t, log.SetLevel(LevelWarn))
+	assert.Equal(t, LevelWarn, info.Level())
+	assert.Equal(t, LevelWarn, errs.Level())
+}
+
+type failingSetLevel struct{ Noop }
+
+func (failingSetLevel) SetLevel(Level) error { return errors.New("eww") }
+
+func Test_Multi_SetLevel_failure(t *testing.T) {
+	var (
+		mem = NewInMemory(LevelInfo)
+		log = Multi(failingSetLevel{}, mem)
+	)
+
+	require.Error(t, log.SetLevel(LevelWarn))
+	assert.Equal(t, LevelWarn, mem.Level(), "other loggers should still be updated")
+}
@@ -6,35 +6,59 @@ type Noop struct{}
 // SetLevel implements Logger for Noop.
 func (Noop) SetLevel(Level) error { return nil }
 
+// Level implements Logger for Noop.
+func (Noop) Level() Level { return LevelQuiet }
+
+// Enabled implements Logger for Noop.
+func (Noop) Enabled(Level) bool { return false }
+
 // Debug implements Logger for Noop.
 func (Noop) Debug(...interface{}) {}
 
 // Debugf implements Logger for Noop.
 func (Noop) Debugf(string, ...interface{}) {}
 
+// Debugw implements Logger for Noop.
+func (Noop) Debugw(string, ...interface{}) {}
+
 // Info implements Logger for Noop.
 func (Noop) Info(...interface{}) {}
 
 // Infof implements Logger for Noop.
 func (Noop) Infof(string, ...interface{}) {}
 
+// Infow implements Logger for Noop.
+func (Noop) Infow(string, ...interface{}) {}
+
 // Warn implements Logger for Noop.
 func (Noop) Warn(...interface{}) {}
 
 // Warnf implements Logger for Noop.
 func (Noop) Warnf(string, ...interface{}) {}
 
+// Warnw implements Logger for Noop.
+func (Noop) Warnw(string, ...interface{}) {}
+
 // Error implements Logger for Noop.
 func (Noop) Error(...interface{}) {}
 
 // Errorf implements Logger for Noop.
 func (Noop) Errorf(string, ...interface{}) {}
 
+// Errorw implements Logger for Noop.
+func (Noop) Errorw(string, ...interface{}) {}
+
 // WithField implements Logger for Noop.
 func (Noop) WithField(string, interface{}) Logger { return Noop{} }
 
 // WithFields implements Logger for Noop.
 func (Noop) WithFields(map[string]interface{}) Logger { return Noop{} }
 
+// With implements Logger for Noop.
+func (Noop) With(...Field) Logger { return Noop{} }
+
 // WithError implements Logger for Noop.
 func (Noop) WithError(error) Logger { return Noop{} }
+
+// Named implements Logger for Noop.
+func (Noop) Named(string) Logger { return Noop{} }
@@ -45,14 +45,22 @@ func Test_NoopUselessButUntestable(*testing.T) {
 
 	log.Debug("debug")
 	log.Debugf("debug")
+	log.Debugw("debug", "a", "b")
 	log.Info("info")
 	log.Infof("info")
+	log.Infow("info", "a", "b")
 	log.Warn("warn")
 	log.Warnf("warn")
+	log.Warnw("warn", "a", "b")
 	log.Error("error")
 	log.Errorf("error")
+	log.Errorw("error", "a", "b")
 	log.WithError(errors.New("eww")).Info("info")
 	log.WithField("a", "b").Info("info")
 	log.WithFields(map[string]interface{}{"a": "b"}).Info("info")
+	log.With(String("a", "b")).Info("info")
+	log.Named("a").Info("info")
 	_ = log.SetLevel(LevelError)
+	_ = log.Level()
+	_ = log.Enabled(LevelError)
 }
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"time"
+)
+
+// ObjectMarshaler is implemented by types that control how they are
+// rendered as field values, instead of being rendered through reflection
+// which exposes all their fields and differs from a logger to another.
+//
+// It is honored by all loggers of this module: zap encodes it as a nested
+// object, slog as a group, logrus as a nested object or as key=value pairs
+// depending on the formatter, others render it as the map returned by MarshalObject.
+type ObjectMarshaler interface {
+	MarshalLogObject(enc ObjectEncoder) error
+}
+
+// ObjectMarshalerFunc is a function that implements ObjectMarshaler.
+type ObjectMarshalerFunc func(enc ObjectEncoder) error
+
+// MarshalLogObject implements ObjectMarshaler.
+func (f ObjectMarshalerFunc) MarshalLogObject(enc ObjectEncoder) error {
+	return f(enc)
+}
+
+// ObjectEncoder is used by ObjectMarshaler to add the fields of the object.
+type ObjectEncoder interface {
+	AddString(key string, value string)
+	AddInt64(key string, value int64)
+	AddFloat64(key string, value float64)
+	AddBool(key string, value bool)
+	AddDuration(key string, value time.Duration)
+	AddTime(key string, value time.Time)
+	// AddObject adds a nested object.
+	AddObject(key string, value ObjectMarshaler) error
+	// AddAny adds a value of any type, it is rendered as a nested
+	// object if it implements ObjectMarshaler, through reflection otherwise.
+	AddAny(key string, value interface{}) error
+}
+
+// Object constructs a field that carries an object rendered by its MarshalLogObject method.
+func Object(key string, value ObjectMarshaler) Field {
+	return Any(key, value)
+}
+
+// MarshalObject returns the fields of the object, nested objects are returned as maps.
+// If the object fails to be marshaled, the error message is set in the
+// FieldErrorKey field along with the fields added so far.
+func MarshalObject(obj ObjectMarshaler) map[string]interface{} {
+	enc := make(mapObjectEncoder)
+	if err := obj.MarshalLogObject(enc); err != nil {
+		enc[FieldErrorKey] = err.Error()
+	}
+	return enc
+}
+
+// ResolveObject returns the fields of v, as returned by
+// MarshalObject, if v is an ObjectMarshaler, v otherwise.
+func ResolveObject(v interface{}) interface{} {
+	if obj, ok := v.(ObjectMarshaler); ok {
+		return MarshalObject(obj)
+	}
+	return v
+}
+
+// mapObjectEncoder is an ObjectEncoder that stores the fields in a map.
+type mapObjectEncoder map[string]interface{}
+
+func (m mapObjectEncoder) AddString(key string, value string)          { m[key] = value }
+func (m mapObjectEncoder) AddInt64(key string, value int64)            { m[key] = value }
+func (m mapObjectEncoder) AddFloat64(key string, value float64)        { m[key] = value }
+func (m mapObjectEncoder) AddBool(key string, value bool)              { m[key] = value }
+func (m mapObjectEncoder) AddDuration(key string, value time.Duration) { m[key] = value }
+func (m mapObjectEncoder) AddTime(key string, value time.Time)         { m[key] = value }
+
+func (m mapObjectEncoder) AddObject(key string, value ObjectMarshaler) error {
+	nested := make(mapObjectEncoder)
+	err := value.MarshalLogObject(nested)
+	m[key] = map[string]interface{}(nested)
+	return err
+}
+
+func (m mapObjectEncoder) AddAny(key string, value interface{}) error {
+	if obj, ok := value.(ObjectMarshaler); ok {
+		return m.AddObject(key, obj)
+	}
+	m[key] = value
+	return nil
+}
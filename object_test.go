@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testAddress struct{ city string }
+
+func (a testAddress) MarshalLogObject(enc ObjectEncoder) error {
+	enc.AddString("city", a.city)
+	return nil
+}
+
+type testUser struct {
+	id       int64
+	password string
+	address  testAddress
+}
+
+func (u testUser) MarshalLogObject(enc ObjectEncoder) error {
+	enc.AddInt64("id", u.id)
+	return enc.AddObject("address", u.address)
+}
+
+func Test_MarshalObject(t *testing.T) {
+	tests := map[string]struct {
+		obj      ObjectMarshaler
+		expected map[string]interface{}
+	}{
+		"nested": {
+			obj: testUser{id: 42, password: "secret", address: testAddress{city: "Paris"}},
+			expected: map[string]interface{}{
+				"id":      int64(42),
+				"address": map[string]interface{}{"city": "Paris"},
+			},
+		}, "all types": {
+			obj: ObjectMarshalerFunc(func(enc ObjectEncoder) error {
+				enc.AddString("string", "hello")
+				enc.AddInt64("int", 42)
+				enc.AddFloat64("float", 3.14)
+				enc.AddBool("bool", true)
+				enc.AddDuration("duration", time.Second)
+				enc.AddTime("time", time.Unix(0, 0))
+				_ = enc.AddAny("list", []int{4, 2})
+				return enc.AddAny("object", testAddress{city: "Paris"})
+			}),
+			expected: map[string]interface{}{
+				"string":   "hello",
+				"int":      int64(42),
+				"float":    3.14,
+				"bool":     true,
+				"duration": time.Second,
+				"time":     time.Unix(0, 0),
+				"list":     []int{4, 2},
+				"object":   map[string]interface{}{"city": "Paris"},
+			},
+		}, "failure": {
+			obj: ObjectMarshalerFunc(func(enc ObjectEncoder) error {
+				enc.AddString("hello", "world")
+				return errors.New("eww")
+			}),
+			expected: map[string]interface{}{
+				"hello":       "world",
+				FieldErrorKey: "eww",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, MarshalObject(test.obj))
+		})
+	}
+}
+
+func Test_ResolveObject(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{"city": "Paris"}, ResolveObject(testAddress{city: "Paris"}))
+	assert.Equal(t, "hello", ResolveObject("hello"))
+}
@@ -0,0 +1,271 @@
+package logger
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+)
+
+// DefaultRedactedKeys are the keys of the fields redacted by default.
+var DefaultRedactedKeys = []string{"password", "secret", "token", "authorization", "cookie"}
+
+// RedactorOption defines a function signature to update the redactor configuration.
+type RedactorOption func(*redactor)
+
+// WithRedactedKeys sets the keys of the fields to redact, case insensitively.
+// Default is DefaultRedactedKeys.
+func WithRedactedKeys(keys ...string) RedactorOption {
+	return func(r *redactor) {
+		r.keys = make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			r.keys[strings.ToLower(key)] = struct{}{}
+		}
+	}
+}
+
+// WithRedactedKeyPatterns adds patterns, as defined by path.Match, matched
+// case insensitively against the keys of the fields to redact, like "*_token".
+func WithRedactedKeyPatterns(patterns ...string) RedactorOption {
+	return func(r *redactor) {
+		for _, pattern := range patterns {
+			r.patterns = append(r.patterns, strings.ToLower(pattern))
+		}
+	}
+}
+
+// WithRedactedTypes adds the types of the values to redact,
+// whatever their key is. Types are given by example, like
+// WithRedactedTypes(Password("")) to redact all Password values.
+// Errors given to WithError are redacted if they, or any error
+// they wrap, have one of these types.
+func WithRedactedTypes(examples ...interface{}) RedactorOption {
+	return func(r *redactor) {
+		for _, example := range examples {
+			r.types = append(r.types, reflect.TypeOf(example))
+		}
+	}
+}
+
+// WithRedactionMask sets the value that replaces redacted values. Default is "[REDACTED]".
+func WithRedactionMask(mask string) RedactorOption {
+	return func(r *redactor) {
+		r.redact = func(interface{}) string { return mask }
+	}
+}
+
+// WithRedactionHash replaces redacted values by a hash of their string
+// representation instead of a mask, so equal values can be correlated
+// without being exposed. Hashes look like "sha256:<16 hex chars>".
+func WithRedactionHash() RedactorOption {
+	return func(r *redactor) {
+		r.redact = func(v interface{}) string {
+			sum := sha256.Sum256([]byte(fmt.Sprint(v)))
+			return "sha256:" + hex.EncodeToString(sum[:8])
+		}
+	}
+}
+
+// NewRedactor returns a logger that redacts the sensitive values of the
+// fields added with WithField, WithFields, With, WithError and with the
+// key-value pairs of Debugw, Infow, Warnw and Errorw.
+// Values are redacted if their key or their type matches one of the rules.
+func NewRedactor(l Logger, opts ...RedactorOption) Logger {
+	r := &redactor{redact: func(interface{}) string { return "[REDACTED]" }}
+	WithRedactedKeys(DefaultRedactedKeys...)(r)
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r.wrap(l)
+}
+
+type redactor struct {
+	keys     map[string]struct{}
+	patterns []string
+	types    []reflect.Type
+	redact   func(interface{}) string
+}
+
+func (r *redactor) wrap(l Logger) Logger {
+	return &redactorNode{
+		wrapper:  &wrapper{Logger: l, handle: r.handle, wrap: r.wrap},
+		redactor: r,
+	}
+}
+
+func (r *redactor) handle(next Logger, e entry) {
+	if e.kind == entryKindPrintw {
+		e.args = r.redactKeysAndValues(e.args)
+	}
+	e.writeTo(next)
+}
+
+func (r *redactor) matchKey(key string) bool {
+	key = strings.ToLower(key)
+
+	if _, ok := r.keys[key]; ok {
+		return true
+	}
+	for _, pattern := range r.patterns {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *redactor) matchType(value interface{}) bool {
+	t := reflect.TypeOf(value)
+	for _, redacted := range r.types {
+		if t == redacted {
+			return true
+		}
+	}
+	return false
+}
+
+// redactValue returns the value to log for the provided key and value.
+func (r *redactor) redactValue(key string, value interface{}) (interface{}, bool) {
+	if r.matchKey(key) {
+		return r.redact(ResolveLazy(value)), true
+	}
+
+	if len(r.types) == 0 {
+		return value, false
+	}
+
+	if lazy, ok := value.(*LazyValue); ok {
+		return Lazy(func() interface{} {
+			if v := lazy.Value(); r.matchType(v) {
+				return r.redact(v)
+			}
+			return lazy.Value()
+		}), true
+	}
+
+	if r.matchType(value) {
+		return r.redact(value), true
+	}
+	return value, false
+}
+
+// errorFields returns the fields describing err, see ErrorFields. If err or
+// any error it wraps has a redacted type, the fields that may contain its
+// message are redacted.
+func (r *redactor) errorFields(err error) []Field {
+	fields := ErrorFields(err)
+	if !r.matchErrorChain(err) {
+		return fields
+	}
+
+	for i, field := range fields {
+		switch field.Key {
+		case FieldErrorKey, FieldErrorChainKey, FieldErrorStackKey:
+			fields[i] = String(field.Key, r.redact(field.Value()))
+		}
+	}
+	return fields
+}
+
+// matchErrorChain returns whether err or any error it wraps has a redacted type.
+func (r *redactor) matchErrorChain(err error) bool {
+	if len(r.types) == 0 {
+		return false
+	}
+	for _, e := range append([]error{err}, unwrapAll(err, nil)...) {
+		if r.matchType(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactField returns the field to log for the provided field.
+func (r *redactor) redactField(field Field) Field {
+	if value, changed := r.redactValue(field.Key, field.Value()); changed {
+		return Any(field.Key, value)
+	}
+	return field
+}
+
+func (r *redactor) redactKeysAndValues(keysAndValues []interface{}) []interface{} {
+	var redacted []interface{}
+
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = fmt.Sprint(keysAndValues[i])
+		}
+
+		value, changed := r.redactValue(key, keysAndValues[i+1])
+		if !changed {
+			continue
+		}
+		if redacted == nil {
+			redacted = make([]interface{}, len(keysAndValues))
+			copy(redacted, keysAndValues)
+		}
+		redacted[i+1] = value
+	}
+
+	if redacted == nil {
+		return keysAndValues
+	}
+	return redacted
+}
+
+// redactorNode is a logger derived from the redactor.
+type redactorNode struct {
+	*wrapper
+	redactor *redactor
+}
+
+// WithField implements Logger for redactorNode.
+func (n *redactorNode) WithField(key string, value interface{}) Logger {
+	value, _ = n.redactor.redactValue(key, value)
+	return n.redactor.wrap(n.wrapper.Logger.WithField(key, value))
+}
+
+// WithFields implements Logger for redactorNode.
+func (n *redactorNode) WithFields(fields map[string]interface{}) Logger {
+	redacted := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		redacted[key], _ = n.redactor.redactValue(key, value)
+	}
+	return n.redactor.wrap(n.wrapper.Logger.WithFields(redacted))
+}
+
+// With implements Logger for redactorNode.
+func (n *redactorNode) With(fields ...Field) Logger {
+	redacted := make([]Field, 0, len(fields))
+	for _, field := range fields {
+		if field.Type == FieldTypeSkip {
+			continue
+		}
+		if err, ok := field.Interface.(error); ok && field.Type == FieldTypeError &&
+			field.Key == FieldErrorKey && !n.redactor.matchKey(FieldErrorKey) {
+			for _, errField := range n.redactor.errorFields(err) {
+				redacted = append(redacted, n.redactor.redactField(errField))
+			}
+			continue
+		}
+		redacted = append(redacted, n.redactor.redactField(field))
+	}
+	return n.redactor.wrap(n.wrapper.Logger.With(redacted...))
+}
+
+// WithError implements Logger for redactorNode, the fields
+// carried by the error are redacted like any other field.
+func (n *redactorNode) WithError(err error) Logger {
+	if err == nil {
+		return n.redactor.wrap(n.wrapper.Logger.WithError(err))
+	}
+	if n.redactor.matchKey(FieldErrorKey) {
+		return n.redactor.wrap(n.wrapper.Logger.WithField(FieldErrorKey, n.redactor.redact(err)))
+	}
+	return n.With(n.redactor.errorFields(err)...)
+}
@@ -0,0 +1,125 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type secretValue string
+
+type secretError struct{}
+
+func (secretError) Error() string { return "user password is hunter2" }
+
+func Test_NewRedactor(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	redacted := NewRedactor(log,
+		WithRedactedKeyPatterns("*_key", "x-*"),
+		WithRedactedTypes(secretValue(""), secretError{}),
+	)
+
+	redacted.
+		WithField("Authorization", "Bearer abc").
+		WithFields(map[string]interface{}{"api_key": "abc", "user": "bob"}).
+		With(String("x-token", "abc"), Int("count", 1), Any("custom", secretValue("abc"))).
+		WithField("lazy", Lazy(func() interface{} { return secretValue("abc") })).
+		Named("child").
+		Infow("info", "password", "abc", "answer", 42, "dangling")
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"Authorization": "[REDACTED]",
+		"api_key":       "[REDACTED]",
+		"user":          "bob",
+		"x-token":       "[REDACTED]",
+		"count":         int64(1),
+		"custom":        "[REDACTED]",
+		"lazy":          "[REDACTED]",
+		"password":      "[REDACTED]",
+		"answer":        42,
+		FieldBadKey:     "dangling",
+		FieldNameKey:    "child",
+	}, log.Entries[0].Fields)
+
+	log.Reset()
+	redacted.WithError(secretError{}).Error("error")
+	redacted.WithError(errors.New("eww")).Error("error")
+	redacted.WithError(nil).Error("error")
+	redacted.WithError(fieldsError{
+		err:    errors.New("eww"),
+		fields: map[string]interface{}{"token": "abc", "user_id": 42},
+	}).Error("error")
+
+	redacted.WithError(fmt.Errorf("wrap: %w", secretError{})).Error("error")
+
+	require.Len(t, log.Entries, 5)
+	assert.Equal(t, "[REDACTED]", log.Entries[0].Fields[FieldErrorKey])
+	assert.Equal(t, "eww", log.Entries[1].Fields[FieldErrorKey])
+	assert.Equal(t, "[REDACTED]", log.Entries[3].Fields["token"])
+	assert.Equal(t, 42, log.Entries[3].Fields["user_id"])
+	assert.Equal(t, map[string]interface{}{
+		FieldErrorKey:      "[REDACTED]",
+		FieldErrorTypeKey:  "*fmt.wrapError",
+		FieldErrorChainKey: "[REDACTED]",
+	}, log.Entries[4].Fields, "errors wrapping a redacted type should be redacted")
+
+	log.Reset()
+	redacted.With(Err(fmt.Errorf("wrap: %w", secretError{}))).Error("error")
+	redacted.With(Err(fieldsError{
+		err:    errors.New("eww"),
+		fields: map[string]interface{}{"token": "abc", "user_id": 42},
+	})).Error("error")
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, "[REDACTED]", log.Entries[0].Fields[FieldErrorKey])
+	assert.Equal(t, "[REDACTED]", log.Entries[0].Fields[FieldErrorChainKey])
+	assert.Equal(t, "[REDACTED]", log.Entries[1].Fields["token"], "fields carried by errors should be redacted")
+	assert.Equal(t, 42, log.Entries[1].Fields["user_id"])
+}
+
+func Test_NewRedactor_options(t *testing.T) {
+	tests := map[string]struct {
+		opts     []RedactorOption
+		key      string
+		value    interface{}
+		expected interface{}
+	}{
+		"default keys": {
+			key:      "token",
+			value:    "abc",
+			expected: "[REDACTED]",
+		}, "overridden keys": {
+			opts:     []RedactorOption{WithRedactedKeys("ssn")},
+			key:      "token",
+			value:    "abc",
+			expected: "abc",
+		}, "mask": {
+			opts:     []RedactorOption{WithRedactedKeys("ssn"), WithRedactionMask("***")},
+			key:      "SSN",
+			value:    "abc",
+			expected: "***",
+		}, "hash": {
+			opts:     []RedactorOption{WithRedactionHash()},
+			key:      "token",
+			value:    Lazy(func() interface{} { return "abc" }),
+			expected: "sha256:ba7816bf8f01cfea",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			log := NewInMemory(LevelDebug)
+			NewRedactor(log, test.opts...).WithField(test.key, test.value).Info("info")
+
+			require.Len(t, log.Entries, 1)
+			assert.Equal(t, test.expected, log.Entries[0].Fields[test.key])
+		})
+	}
+}
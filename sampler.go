@@ -0,0 +1,191 @@
+package logger
+
+import (
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+)
+
+const samplerCountersPerLevel = 4096
+
+// SamplerOption defines a function signature to update the sampler configuration.
+type SamplerOption func(*Sampler)
+
+// WithSamplerTick sets the interval during which the first
+// entries of each message are logged. Default is 1s.
+func WithSamplerTick(tick time.Duration) SamplerOption {
+	return func(s *Sampler) {
+		s.tick = tick
+	}
+}
+
+// WithSamplerFirst sets the number of entries with the same
+// level and message logged during each tick. Default is 100.
+func WithSamplerFirst(first uint64) SamplerOption {
+	return func(s *Sampler) {
+		s.first = first
+	}
+}
+
+// WithSamplerThereafter sets the sampling rate once the first entries
+// have been logged: every Mth entry is logged. Zero drops all of them.
+// Default is 100.
+func WithSamplerThereafter(thereafter uint64) SamplerOption {
+	return func(s *Sampler) {
+		s.thereafter = thereafter
+	}
+}
+
+// WithSamplerReportInterval sets the minimum interval between two reports of
+// the dropped entries counters. Zero disables the reports. Default is 1m.
+func WithSamplerReportInterval(interval time.Duration) SamplerOption {
+	return func(s *Sampler) {
+		s.reportInterval = interval
+	}
+}
+
+// NewSampler returns a logger that samples the entries written to the
+// provided logger: for each level and message, the first N entries of
+// each tick are logged, then every Mth entry only.
+// Dropped entries are counted and periodically reported at the 'warn' level.
+func NewSampler(l Logger, opts ...SamplerOption) *Sampler {
+	s := newSampler(l, opts...)
+	s.Logger = s.wrap(l)
+	return s
+}
+
+// Sampler is a logger that samples the entries logged through it, and
+// through all the loggers derived from it.
+//
+// The dropped entries are reported when an entry is logged, at least the
+// report interval after the previous report. Nothing is reported while
+// nothing is logged, Flush should be called to report the remaining
+// dropped entries, before exiting for instance.
+type Sampler struct {
+	Logger
+
+	tick           time.Duration
+	first          uint64
+	thereafter     uint64
+	reportInterval time.Duration
+	now            func() time.Time
+
+	reporter   Logger
+	lastReport atomic.Int64
+	dropped    [LevelQuiet]atomic.Uint64
+	counters   [LevelQuiet][samplerCountersPerLevel]samplerCounter
+}
+
+func newSampler(l Logger, opts ...SamplerOption) *Sampler {
+	s := &Sampler{
+		tick:           time.Second,
+		first:          100,
+		thereafter:     100,
+		reportInterval: time.Minute,
+		now:            time.Now,
+		reporter:       l,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *Sampler) wrap(l Logger) Logger {
+	return &wrapper{Logger: l, handle: s.handle, wrap: s.wrap}
+}
+
+func (s *Sampler) handle(next Logger, e entry) {
+	if e.level < LevelDebug || e.level >= LevelQuiet || !next.Enabled(e.level) {
+		return
+	}
+
+	now := s.now()
+	defer s.reportDropped(now)
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(e.samplingKey()))
+	counter := &s.counters[e.level][h.Sum32()%samplerCountersPerLevel]
+
+	n := counter.incCheckReset(now, s.tick)
+	if n > s.first && (s.thereafter == 0 || (n-s.first)%s.thereafter != 0) {
+		s.dropped[e.level].Add(1)
+		return
+	}
+
+	e.writeTo(next)
+}
+
+func (s *Sampler) reportDropped(now time.Time) {
+	if s.reportInterval <= 0 {
+		return
+	}
+
+	last := s.lastReport.Load()
+	if last == 0 { // first entry, start counting from now
+		s.lastReport.CompareAndSwap(0, now.UnixNano())
+		return
+	}
+
+	if now.UnixNano()-last < s.reportInterval.Nanoseconds() || !s.lastReport.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	s.report()
+}
+
+// Flush reports the entries dropped since the last report, if any,
+// unless the reports are disabled.
+func (s *Sampler) Flush() {
+	if s.reportInterval <= 0 {
+		return
+	}
+
+	s.lastReport.Store(s.now().UnixNano())
+	s.report()
+}
+
+func (s *Sampler) report() {
+	dropped := make(map[string]uint64)
+	for lvl := range s.dropped {
+		if n := s.dropped[lvl].Swap(0); n > 0 {
+			dropped[Level(lvl).String()] = n
+		}
+	}
+
+	if len(dropped) > 0 {
+		s.reporter.Warnw("log entries dropped by sampler", "dropped", dropped)
+	}
+}
+
+// samplingKey returns the key used to group similar entries,
+// without formatting the message when possible.
+func (e entry) samplingKey() string {
+	if e.kind == entryKindPrintf {
+		return e.format
+	}
+	return e.message()
+}
+
+type samplerCounter struct {
+	resetAt atomic.Int64
+	n       atomic.Uint64
+}
+
+func (c *samplerCounter) incCheckReset(t time.Time, tick time.Duration) uint64 {
+	tn := t.UnixNano()
+
+	resetAt := c.resetAt.Load()
+	if resetAt > tn {
+		return c.n.Add(1)
+	}
+
+	c.n.Store(1)
+	if !c.resetAt.CompareAndSwap(resetAt, tn+tick.Nanoseconds()) {
+		// another goroutine reset the counter first
+		return c.n.Add(1)
+	}
+	return 1
+}
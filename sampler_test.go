@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewSampler(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	sampled := NewSampler(log, WithSamplerFirst(2), WithSamplerThereafter(3))
+
+	for i := 0; i < 10; i++ {
+		sampled.Infof("hello %d", i)
+	}
+
+	// first 2, then every 3rd: 5th and 8th
+	require.Len(t, log.Entries, 4)
+	for i, expected := range []int{0, 1, 4, 7} {
+		assert.Equal(t, []interface{}{expected}, log.Entries[i].Args)
+	}
+}
+
+func Test_Sampler_Flush(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+	sampled := NewSampler(log, WithSamplerFirst(1), WithSamplerThereafter(0))
+
+	sampled.Info("hello")
+	sampled.Info("hello")
+	sampled.WithField("child", true).Warn("hello")
+	sampled.WithField("child", true).Warn("hello")
+	require.Len(t, log.Entries, 2)
+
+	sampled.Flush()
+	require.Len(t, log.Entries, 3)
+	assert.Equal(t, []interface{}{"log entries dropped by sampler"}, log.Entries[2].Args)
+	assert.Equal(t, map[string]interface{}{
+		"dropped": map[string]uint64{"info": 1, "warn": 1},
+	}, log.Entries[2].Fields)
+
+	sampled.Flush()
+	require.Len(t, log.Entries, 3, "nothing to report")
+
+	log.Reset()
+	sampled = NewSampler(log, WithSamplerFirst(1), WithSamplerThereafter(0), WithSamplerReportInterval(0))
+	sampled.Info("hello")
+	sampled.Info("hello")
+	sampled.Flush()
+	require.Len(t, log.Entries, 1, "reports are disabled")
+}
+
+func Test_sampler_handle(t *testing.T) {
+	var (
+		log = NewInMemory(LevelInfo)
+		now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	s := newSampler(log,
+		WithSamplerTick(time.Second),
+		WithSamplerFirst(1),
+		WithSamplerThereafter(0),
+		WithSamplerReportInterval(time.Minute),
+	)
+	s.now = func() time.Time { return now }
+	sampled := s.wrap(log)
+
+	t.Run("disabled levels are not counted", func(t *testing.T) {
+		sampled.Debug("debug")
+		sampled.Debug("debug")
+		assert.Empty(t, log.Entries)
+		assert.Zero(t, s.dropped[LevelDebug].Load())
+	})
+
+	t.Run("entries are sampled by level and message", func(t *testing.T) {
+		log.Reset()
+
+		sampled.Info("hello")
+		sampled.Info("hello")
+		sampled.Warn("hello")
+		sampled.WithField("child", true).Info("world")
+		sampled.Info("world")
+		sampled.Infow("world", "answer", 42)
+
+		require.Len(t, log.Entries, 3)
+		assert.Equal(t, LevelInfo, log.Entries[0].Level)
+		assert.Equal(t, LevelWarn, log.Entries[1].Level)
+		assert.Equal(t, map[string]interface{}{"child": true}, log.Entries[2].Fields)
+		assert.Equal(t, uint64(3), s.dropped[LevelInfo].Load())
+	})
+
+	t.Run("counters are reset each tick", func(t *testing.T) {
+		log.Reset()
+		now = now.Add(time.Second)
+
+		sampled.Info("hello")
+		sampled.Info("hello")
+
+		require.Len(t, log.Entries, 1)
+		assert.Equal(t, uint64(4), s.dropped[LevelInfo].Load())
+	})
+
+	t.Run("dropped entries are reported", func(t *testing.T) {
+		log.Reset()
+		now = now.Add(time.Minute)
+
+		sampled.Info("report")
+
+		require.Len(t, log.Entries, 2)
+		assert.Equal(t, LevelWarn, log.Entries[1].Level)
+		assert.Equal(t, []interface{}{"log entries dropped by sampler"}, log.Entries[1].Args)
+		assert.Equal(t, map[string]interface{}{
+			"dropped": map[string]uint64{"info": 4},
+		}, log.Entries[1].Fields)
+		assert.Zero(t, s.dropped[LevelInfo].Load())
+
+		log.Reset()
+		now = now.Add(time.Minute)
+		sampled.Info("report")
+		require.Len(t, log.Entries, 1, "nothing to report")
+	})
+}
+
+func Test_samplerCounter_concurrency(t *testing.T) {
+	var (
+		counter samplerCounter
+		wg      sync.WaitGroup
+		now     = time.Now()
+	)
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counter.incCheckReset(now, time.Hour)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(100), counter.n.Load())
+}
@@ -0,0 +1,244 @@
+package logger
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+// ScrubRule describes values to scrub from messages and string fields.
+type ScrubRule struct {
+	// Pattern matches the candidate values.
+	Pattern *regexp.Regexp
+	// Validate, if set, tells whether a value matched by Pattern has to be scrubbed.
+	Validate func(string) bool
+	// Hint, if set, is a cheap check the string must pass
+	// for the pattern to be applied, to avoid useless regexps.
+	Hint func(string) bool
+}
+
+var (
+	// ScrubJWT scrubs JSON web tokens.
+	ScrubJWT = ScrubRule{
+		Pattern: regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+		Hint:    func(s string) bool { return strings.Contains(s, "eyJ") },
+	}
+	// ScrubEmail scrubs email addresses.
+	ScrubEmail = ScrubRule{
+		Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+		Hint:    func(s string) bool { return strings.IndexByte(s, '@') >= 0 },
+	}
+	// ScrubCardNumber scrubs payment card numbers, validated using the Luhn algorithm.
+	ScrubCardNumber = ScrubRule{
+		Pattern:  regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		Validate: luhnValid,
+		Hint:     func(s string) bool { return countDigits(s) >= 13 },
+	}
+	// ScrubIPv4 scrubs IPv4 addresses.
+	ScrubIPv4 = ScrubRule{
+		Pattern: regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\.){3}(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\b`),
+		Hint:    func(s string) bool { return strings.Count(s, ".") >= 3 },
+	}
+	// ScrubIPv6 scrubs IPv6 addresses.
+	ScrubIPv6 = ScrubRule{
+		Pattern: regexp.MustCompile(`(?i)[0-9a-f]*:[0-9a-f:]+(?:\.\d+\.\d+\.\d+)?`),
+		Validate: func(s string) bool {
+			return strings.Count(s, ":") >= 2 && net.ParseIP(s) != nil
+		},
+		Hint: func(s string) bool { return strings.Count(s, ":") >= 2 },
+	}
+
+	// DefaultScrubRules are the rules applied by default by the scrubber.
+	DefaultScrubRules = []ScrubRule{ScrubJWT, ScrubEmail, ScrubCardNumber, ScrubIPv4, ScrubIPv6}
+)
+
+// ScrubberOption defines a function signature to update the scrubber configuration.
+type ScrubberOption func(*scrubber)
+
+// WithScrubRules sets the rules applied by the scrubber. Default is DefaultScrubRules.
+func WithScrubRules(rules ...ScrubRule) ScrubberOption {
+	return func(s *scrubber) {
+		s.rules = rules
+	}
+}
+
+// WithScrubMask sets the value that replaces scrubbed values. Default is "[SCRUBBED]".
+func WithScrubMask(mask string) ScrubberOption {
+	return func(s *scrubber) {
+		s.mask = mask
+	}
+}
+
+// NewScrubber returns a logger that masks the values matching the configured
+// rules, like emails or card numbers, in the messages and in the string
+// values of the fields. Messages are only formatted and scrubbed if the
+// entry is enabled.
+func NewScrubber(l Logger, opts ...ScrubberOption) Logger {
+	s := &scrubber{
+		rules: DefaultScrubRules,
+		mask:  "[SCRUBBED]",
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s.wrap(l)
+}
+
+type scrubber struct {
+	rules []ScrubRule
+	mask  string
+}
+
+func (s *scrubber) wrap(l Logger) Logger {
+	return &scrubberNode{
+		wrapper:  &wrapper{Logger: l, handle: s.handle, wrap: s.wrap},
+		scrubber: s,
+	}
+}
+
+func (s *scrubber) handle(next Logger, e entry) {
+	if !next.Enabled(e.level) {
+		return
+	}
+
+	switch e.kind {
+	case entryKindPrintw:
+		e.format, _ = s.scrub(e.format)
+		e.args = s.scrubKeysAndValues(e.args)
+	default:
+		// the message is formatted only once, and the original
+		// entry is kept as is if there is nothing to scrub
+		if msg, changed := s.scrub(e.message()); changed {
+			e = entry{level: e.level, kind: entryKindPrint, args: []interface{}{msg}}
+		}
+	}
+
+	e.writeTo(next)
+}
+
+// scrub returns str with all matching values masked, and whether str was changed.
+func (s *scrubber) scrub(str string) (string, bool) {
+	scrubbed := str
+
+	for _, rule := range s.rules {
+		if rule.Hint != nil && !rule.Hint(scrubbed) {
+			continue
+		}
+
+		scrubbed = rule.Pattern.ReplaceAllStringFunc(scrubbed, func(match string) string {
+			if rule.Validate != nil && !rule.Validate(match) {
+				return match
+			}
+			return s.mask
+		})
+	}
+
+	return scrubbed, scrubbed != str
+}
+
+// scrubValue scrubs the value if it is a string.
+func (s *scrubber) scrubValue(value interface{}) interface{} {
+	if str, ok := value.(string); ok {
+		value, _ = s.scrub(str)
+	}
+	return value
+}
+
+func (s *scrubber) scrubKeysAndValues(keysAndValues []interface{}) []interface{} {
+	scrubbed := make([]interface{}, len(keysAndValues))
+	for i, value := range keysAndValues {
+		if i%2 == 1 || i == len(keysAndValues)-1 {
+			value = s.scrubValue(value)
+		}
+		scrubbed[i] = value
+	}
+	return scrubbed
+}
+
+// scrubberNode is a logger derived from the scrubber.
+type scrubberNode struct {
+	*wrapper
+	scrubber *scrubber
+}
+
+// WithField implements Logger for scrubberNode.
+func (n *scrubberNode) WithField(key string, value interface{}) Logger {
+	return n.scrubber.wrap(n.wrapper.Logger.WithField(key, n.scrubber.scrubValue(value)))
+}
+
+// WithFields implements Logger for scrubberNode.
+func (n *scrubberNode) WithFields(fields map[string]interface{}) Logger {
+	scrubbed := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		scrubbed[key] = n.scrubber.scrubValue(value)
+	}
+	return n.scrubber.wrap(n.wrapper.Logger.WithFields(scrubbed))
+}
+
+// With implements Logger for scrubberNode.
+func (n *scrubberNode) With(fields ...Field) Logger {
+	fields = ExpandErrorFields(fields)
+	scrubbed := make([]Field, len(fields))
+	for i, field := range fields {
+		switch field.Type {
+		case FieldTypeString:
+			field.String, _ = n.scrubber.scrub(field.String)
+		case FieldTypeAny:
+			if strs, ok := field.Interface.([]string); ok {
+				scrubbedStrs := make([]string, len(strs))
+				for j, str := range strs {
+					scrubbedStrs[j], _ = n.scrubber.scrub(str)
+				}
+				field.Interface = scrubbedStrs
+			} else {
+				field.Interface = n.scrubber.scrubValue(field.Interface)
+			}
+		}
+		scrubbed[i] = field
+	}
+	return n.scrubber.wrap(n.wrapper.Logger.With(scrubbed...))
+}
+
+// WithError implements Logger for scrubberNode,
+// all the fields describing the error are scrubbed.
+func (n *scrubberNode) WithError(err error) Logger {
+	if err == nil {
+		return n.scrubber.wrap(n.wrapper.Logger.WithError(err))
+	}
+	return n.With(ErrorFields(err)...)
+}
+
+func countDigits(s string) int {
+	var n int
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+// luhnValid returns true if the digits of s pass the Luhn checksum.
+func luhnValid(s string) bool {
+	var sum, digits int
+
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+
+		d := int(c - '0')
+		if digits%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
@@ -0,0 +1,169 @@
+package logger
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+// SignalOption defines a function signature to update the signals handling configuration.
+type SignalOption func(*signalConfig)
+
+type signalConfig struct {
+	decrease    []os.Signal
+	increase    []os.Signal
+	toggle      []os.Signal
+	toggleLevel Level
+	revertAfter time.Duration
+}
+
+// WithSignalDecrease sets the signals that lower the level by one
+// step, towards the 'debug' level. Default is SIGUSR1 on unix systems.
+func WithSignalDecrease(signals ...os.Signal) SignalOption {
+	return func(c *signalConfig) {
+		c.decrease = signals
+	}
+}
+
+// WithSignalIncrease sets the signals that raise the level by one
+// step, towards the 'error' level. Default is SIGUSR2 on unix systems.
+func WithSignalIncrease(signals ...os.Signal) SignalOption {
+	return func(c *signalConfig) {
+		c.increase = signals
+	}
+}
+
+// WithSignalToggle sets the signals that switch the level to the provided
+// level, and back to the previous level when received again. If revertAfter
+// is positive, the previous level is automatically restored after that delay.
+func WithSignalToggle(lvl Level, revertAfter time.Duration, signals ...os.Signal) SignalOption {
+	return func(c *signalConfig) {
+		c.toggle = signals
+		c.toggleLevel = lvl
+		c.revertAfter = revertAfter
+	}
+}
+
+// HandleLevelSignals updates the level of the provided logger when the
+// configured signals are received. Each level transition is logged using
+// the same logger. The returned function stops listening for signals.
+func HandleLevelSignals(l Logger, opts ...SignalOption) func() {
+	c := signalConfig{
+		decrease: defaultSignalDecrease,
+		increase: defaultSignalIncrease,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	listened := append(append(append([]os.Signal(nil), c.decrease...), c.increase...), c.toggle...)
+	if len(listened) == 0 { // signal.Notify would relay all signals
+		return func() {}
+	}
+
+	var (
+		signals = make(chan os.Signal, 1)
+		stop    = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+
+	signal.Notify(signals, listened...)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		var (
+			toggled  bool
+			previous Level
+			revert   <-chan time.Time
+			timer    *time.Timer
+		)
+
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-revert:
+				toggled, revert = false, nil
+				setLevelAndLog(l, previous, "timeout")
+			case sig := <-signals:
+				switch {
+				case containsSignal(c.decrease, sig):
+					if lvl := l.Level(); lvl > LevelDebug {
+						setLevelAndLog(l, lvl-1, sig.String())
+					}
+				case containsSignal(c.increase, sig):
+					if lvl := l.Level(); lvl < LevelError {
+						setLevelAndLog(l, lvl+1, sig.String())
+					}
+				case containsSignal(c.toggle, sig) && toggled:
+					toggled, revert = false, nil
+					setLevelAndLog(l, previous, sig.String())
+				case containsSignal(c.toggle, sig):
+					toggled, previous = true, l.Level()
+					setLevelAndLog(l, c.toggleLevel, sig.String())
+					if c.revertAfter > 0 {
+						if timer != nil {
+							timer.Stop()
+						}
+						timer = time.NewTimer(c.revertAfter)
+						revert = timer.C
+					}
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			close(stop)
+			wg.Wait()
+		})
+	}
+}
+
+// setLevelAndLog sets the level of the logger and logs the transition
+// while the most verbose of the previous and next levels is active.
+func setLevelAndLog(l Logger, to Level, reason string) {
+	from := l.Level()
+	if from == to {
+		return
+	}
+
+	log := func(lvl Level) {
+		LogWAtLevelFunc(l, lvl)("log level changed", "from", from.String(), "to", to.String(), "reason", reason)
+	}
+
+	if to > from {
+		log(from)
+	}
+
+	if err := l.SetLevel(to); err != nil {
+		l.Errorw("unable to change log level", "from", from.String(), "to", to.String(), "reason", reason, FieldErrorKey, err)
+		return
+	}
+
+	if to < from {
+		log(to)
+	}
+}
+
+func containsSignal(signals []os.Signal, sig os.Signal) bool {
+	for _, s := range signals {
+		if s == sig {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+//go:build !unix
+
+package logger
+
+import (
+	"os"
+)
+
+// signals to change levels are only defined on unix systems.
+var (
+	defaultSignalDecrease []os.Signal
+	defaultSignalIncrease []os.Signal
+)
@@ -0,0 +1,13 @@
+//go:build unix
+
+package logger
+
+import (
+	"os"
+	"syscall"
+)
+
+var (
+	defaultSignalDecrease = []os.Signal{syscall.SIGUSR1}
+	defaultSignalIncrease = []os.Signal{syscall.SIGUSR2}
+)
@@ -0,0 +1,68 @@
+//go:build unix
+
+package logger
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendSignal(t *testing.T, sig syscall.Signal) {
+	require.NoError(t, syscall.Kill(syscall.Getpid(), sig))
+}
+
+func Test_HandleLevelSignals(t *testing.T) {
+	log := newLevelNotifier(LevelInfo)
+	stop := HandleLevelSignals(log)
+
+	sendSignal(t, syscall.SIGUSR1)
+	assert.Equal(t, LevelDebug, waitLevel(t, log.changes))
+
+	sendSignal(t, syscall.SIGUSR2)
+	assert.Equal(t, LevelInfo, waitLevel(t, log.changes))
+
+	sendSignal(t, syscall.SIGUSR2)
+	assert.Equal(t, LevelWarn, waitLevel(t, log.changes))
+
+	stop()
+	stop()
+
+	require.Len(t, log.Entries, 3)
+	for i, expected := range []struct{ level, from, to string }{
+		{level: "debug", from: "info", to: "debug"},
+		{level: "debug", from: "debug", to: "info"},
+		{level: "info", from: "info", to: "warn"},
+	} {
+		assert.Equal(t, expected.level, log.Entries[i].Level.String())
+		assert.Equal(t, []interface{}{"log level changed"}, log.Entries[i].Args)
+		assert.Equal(t, expected.from, log.Entries[i].Fields["from"])
+		assert.Equal(t, expected.to, log.Entries[i].Fields["to"])
+		assert.NotEmpty(t, log.Entries[i].Fields["reason"])
+	}
+}
+
+func Test_HandleLevelSignals_toggle(t *testing.T) {
+	log := newLevelNotifier(LevelWarn)
+	stop := HandleLevelSignals(log,
+		WithSignalDecrease(), WithSignalIncrease(),
+		WithSignalToggle(LevelDebug, 50*time.Millisecond, syscall.SIGUSR1),
+	)
+	defer stop()
+
+	sendSignal(t, syscall.SIGUSR1)
+	assert.Equal(t, LevelDebug, waitLevel(t, log.changes))
+	sendSignal(t, syscall.SIGUSR1)
+	assert.Equal(t, LevelWarn, waitLevel(t, log.changes))
+
+	sendSignal(t, syscall.SIGUSR1)
+	assert.Equal(t, LevelDebug, waitLevel(t, log.changes))
+	assert.Equal(t, LevelWarn, waitLevel(t, log.changes), "level should be reverted after the timeout")
+
+	stop()
+	require.Len(t, log.Entries, 4)
+	assert.Equal(t, "timeout", log.Entries[3].Fields["reason"])
+}
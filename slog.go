@@ -0,0 +1,218 @@
+package logger
+
+import (
+	"context"
+	stdlog "log"
+	"log/slog"
+)
+
+// SlogHandlerOption defines a function signature to update the slog handler.
+type SlogHandlerOption func(*slogHandler)
+
+// WithSlogNestedGroups configures the slog handler to render slog groups
+// as nested fields instead of prefixing the keys with the group name.
+func WithSlogNestedGroups() SlogHandlerOption {
+	return func(h *slogHandler) {
+		h.nested = true
+	}
+}
+
+// WithSlogGroupSeparator configures the separator used between the
+// group names and the keys when groups are not nested. Default is '.'.
+func WithSlogGroupSeparator(sep string) SlogHandlerOption {
+	return func(h *slogHandler) {
+		h.separator = sep
+	}
+}
+
+// Slog returns a standard structured logger which will use
+// the provided logger internally.
+func Slog(l Logger, opts ...SlogHandlerOption) *slog.Logger {
+	return slog.New(SlogHandler(l, opts...))
+}
+
+// RedirectSlog redirects the default slog logger calls to the underlying logger.
+// As slog.SetDefault also redirects the standard logger to the slog default
+// logger, the returned function restores both of them.
+func RedirectSlog(l Logger, opts ...SlogHandlerOption) func() {
+	oldDefault := slog.Default()
+	oldFlags := stdlog.Flags()
+	oldOutput := stdlog.Writer()
+
+	slog.SetDefault(Slog(l, opts...))
+
+	return func() {
+		slog.SetDefault(oldDefault)
+		stdlog.SetFlags(oldFlags)
+		stdlog.SetOutput(oldOutput)
+	}
+}
+
+// SlogHandler returns a slog.Handler that writes all records to the provided logger.
+func SlogHandler(l Logger, opts ...SlogHandlerOption) slog.Handler {
+	h := &slogHandler{
+		log:       l,
+		separator: ".",
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+type slogHandler struct {
+	log       Logger
+	nested    bool
+	separator string
+
+	// prefix is prepended to all keys when groups are not nested.
+	prefix string
+	// groups and pending are the currently opened groups and the attributes
+	// added to each of them, they are only used when groups are nested.
+	groups  []string
+	pending [][]slog.Attr
+}
+
+// SlogLevel converts a slog level to the closest logger level.
+func SlogLevel(lvl slog.Level) Level {
+	switch {
+	case lvl < slog.LevelInfo:
+		return LevelDebug
+	case lvl < slog.LevelWarn:
+		return LevelInfo
+	case lvl < slog.LevelError:
+		return LevelWarn
+	default:
+		return LevelError
+	}
+}
+
+// Enabled implements slog.Handler.
+func (h *slogHandler) Enabled(_ context.Context, lvl slog.Level) bool {
+	return h.log.Enabled(SlogLevel(lvl))
+}
+
+// Handle implements slog.Handler.
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(attr slog.Attr) bool {
+		attrs = append(attrs, attr)
+		return true
+	})
+
+	log := h.log
+	if fields := h.recordFields(attrs); len(fields) > 0 {
+		log = log.WithFields(fields)
+	}
+
+	LogAtLevelFunc(log, SlogLevel(r.Level))(r.Message)
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	child := h.clone()
+
+	if h.nested && len(h.groups) > 0 {
+		child.pending = make([][]slog.Attr, len(h.pending))
+		copy(child.pending, h.pending)
+
+		last := len(child.pending) - 1
+		child.pending[last] = append(append([]slog.Attr(nil), h.pending[last]...), attrs...)
+		return child
+	}
+
+	fields := make(map[string]interface{}, len(attrs))
+	h.addAttrs(fields, h.prefix, attrs)
+	if len(fields) > 0 {
+		child.log = h.log.WithFields(fields)
+	}
+
+	return child
+}
+
+// WithGroup implements slog.Handler.
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	child := h.clone()
+	if h.nested {
+		child.groups = append(child.groups[:len(child.groups):len(child.groups)], name)
+		child.pending = append(child.pending[:len(child.pending):len(child.pending)], nil)
+	} else {
+		child.prefix = h.prefix + name + h.separator
+	}
+
+	return child
+}
+
+func (h *slogHandler) clone() *slogHandler {
+	child := *h
+	return &child
+}
+
+func (h *slogHandler) recordFields(attrs []slog.Attr) map[string]interface{} {
+	if !h.nested || len(h.groups) == 0 {
+		if len(attrs) == 0 {
+			return nil
+		}
+		fields := make(map[string]interface{}, len(attrs))
+		h.addAttrs(fields, h.prefix, attrs)
+		return fields
+	}
+
+	// build nested groups from the innermost one to the outermost one
+	group := make(map[string]interface{}, len(attrs))
+	h.addAttrs(group, "", h.pending[len(h.pending)-1])
+	h.addAttrs(group, "", attrs)
+
+	for i := len(h.groups) - 1; i > 0; i-- {
+		parent := make(map[string]interface{}, len(h.pending[i-1])+1)
+		h.addAttrs(parent, "", h.pending[i-1])
+		if len(group) > 0 {
+			parent[h.groups[i]] = group
+		}
+		group = parent
+	}
+
+	if len(group) == 0 {
+		return nil
+	}
+	return map[string]interface{}{h.groups[0]: group}
+}
+
+func (h *slogHandler) addAttrs(fields map[string]interface{}, prefix string, attrs []slog.Attr) {
+	for _, attr := range attrs {
+		attr.Value = attr.Value.Resolve()
+
+		if attr.Equal(slog.Attr{}) {
+			continue
+		}
+
+		if attr.Value.Kind() != slog.KindGroup {
+			fields[prefix+attr.Key] = attr.Value.Any()
+			continue
+		}
+
+		group := attr.Value.Group()
+		switch {
+		case len(group) == 0:
+		case attr.Key == "": // inlined group
+			h.addAttrs(fields, prefix, group)
+		case h.nested:
+			sub := make(map[string]interface{}, len(group))
+			h.addAttrs(sub, "", group)
+			fields[prefix+attr.Key] = sub
+		default:
+			h.addAttrs(fields, prefix+attr.Key+h.separator, group)
+		}
+	}
+}
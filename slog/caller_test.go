@@ -0,0 +1,38 @@
+package slog
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func TestSlog_caller(t *testing.T) {
+	log, buf := newDeterministicLogger(t, WithCaller(), WithStacktrace(logger.LevelError))
+
+	// the caller must be found through any number of wrappers
+	child := logger.NewSampler(logger.NewRedactor(log)).WithField("hello", "world")
+	child.Warn("warn")
+	child.Error("error")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var warn, errorOutput map[string]interface{}
+	require.NoError(t, json.Unmarshal(lines[0], &warn))
+	require.NoError(t, json.Unmarshal(lines[1], &errorOutput))
+
+	require.Contains(t, warn, "source")
+	source := warn["source"].(map[string]interface{})
+	assert.True(t, strings.HasSuffix(source["file"].(string), "slog/caller_test.go"))
+	assert.Equal(t, "github.com/krostar/logger/slog.TestSlog_caller", source["function"])
+	assert.NotContains(t, warn, logger.FieldStacktraceKey)
+
+	require.Contains(t, errorOutput, logger.FieldStacktraceKey)
+	assert.True(t, strings.HasPrefix(errorOutput[logger.FieldStacktraceKey].(string), "github.com/krostar/logger/slog.TestSlog_caller\n"))
+}
@@ -0,0 +1,100 @@
+package slog
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/krostar/logger"
+)
+
+// hookHandler fires hooks with the records handled by the handler it wraps,
+// it keeps the attributes added to the handler as slog handlers do not expose them.
+type hookHandler struct {
+	slog.Handler
+	hooks  []logger.Hook
+	fields map[string]interface{}
+	groups []string
+}
+
+// WithAttrs implements slog.Handler for hookHandler.
+func (h *hookHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	child := *h
+	child.Handler = h.Handler.WithAttrs(attrs)
+	child.fields = withAttrs(h.fields, h.groups, attrs)
+	return &child
+}
+
+// WithGroup implements slog.Handler for hookHandler.
+func (h *hookHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	child := *h
+	child.Handler = h.Handler.WithGroup(name)
+	child.groups = append(h.groups[:len(h.groups):len(h.groups)], name)
+	return &child
+}
+
+// Handle implements slog.Handler for hookHandler.
+func (h *hookHandler) Handle(ctx context.Context, record slog.Record) error {
+	err := h.Handler.Handle(ctx, record)
+
+	attrs := make([]slog.Attr, 0, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = append(attrs, attr)
+		return true
+	})
+
+	entry := logger.HookEntry{
+		Time:    record.Time,
+		Level:   logger.SlogLevel(record.Level),
+		Message: record.Message,
+		Fields:  withAttrs(h.fields, h.groups, attrs),
+	}
+	for _, hook := range h.hooks {
+		hook.Fire(entry)
+	}
+
+	return err
+}
+
+// withAttrs returns a copy of fields with the attributes added in the provided group.
+func withAttrs(fields map[string]interface{}, groups []string, attrs []slog.Attr) map[string]interface{} {
+	copied := make(map[string]interface{}, len(fields)+len(attrs))
+	for key, value := range fields {
+		copied[key] = value
+	}
+
+	if len(groups) > 0 {
+		nested, _ := copied[groups[0]].(map[string]interface{})
+		copied[groups[0]] = withAttrs(nested, groups[1:], attrs)
+		return copied
+	}
+
+	for _, attr := range attrs {
+		addAttr(copied, attr)
+	}
+	return copied
+}
+
+// addAttr adds the resolved value of the attribute to fields, groups are added as maps.
+func addAttr(fields map[string]interface{}, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() != slog.KindGroup {
+		fields[attr.Key] = attr.Value.Any()
+		return
+	}
+
+	group := fields
+	if attr.Key != "" {
+		group = make(map[string]interface{})
+		fields[attr.Key] = group
+	}
+	for _, a := range attr.Value.Group() {
+		addAttr(group, a)
+	}
+}
@@ -0,0 +1,67 @@
+package slog
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func Test_WithHooks(t *testing.T) {
+	var entries []logger.HookEntry
+
+	log, buf := newDeterministicLogger(t, WithHooks(logger.HookFunc(func(entry logger.HookEntry) {
+		entries = append(entries, entry)
+	})))
+
+	child := log.Named("db").
+		WithField("lazy", logger.Lazy(func() interface{} { return 42 })).
+		With(logger.Object("object", logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+			enc.AddString("hello", "world")
+			return nil
+		})))
+	child.Debug("debug")
+	child.Warnf("warn %d", 42)
+	child.Errorw("error", "hello", "world")
+
+	assert.NotEmpty(t, buf.String(), "entries should still be written")
+	require.Len(t, entries, 2)
+	assert.False(t, entries[0].Time.IsZero())
+	assert.Equal(t, logger.LevelWarn, entries[0].Level)
+	assert.Equal(t, "warn 42", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"lazy":              int64(42),
+		"object":            map[string]interface{}{"hello": "world"},
+		logger.FieldNameKey: "db",
+	}, entries[0].Fields)
+	assert.Equal(t, logger.LevelError, entries[1].Level)
+	assert.Equal(t, "error", entries[1].Message)
+	assert.Equal(t, "world", entries[1].Fields["hello"])
+}
+
+func Test_hookHandler_groups(t *testing.T) {
+	var fields map[string]interface{}
+
+	handler := &hookHandler{
+		Handler: slog.NewJSONHandler(io.Discard, nil),
+		hooks:   []logger.Hook{logger.HookFunc(func(entry logger.HookEntry) { fields = entry.Fields })},
+	}
+
+	log := slog.New(handler).With("root", 1).WithGroup("a").With("b", 2).WithGroup("c")
+	log.Info("info", "d", 3, slog.Group("e", "f", 4))
+
+	assert.Equal(t, map[string]interface{}{
+		"root": int64(1),
+		"a": map[string]interface{}{
+			"b": int64(2),
+			"c": map[string]interface{}{
+				"d": int64(3),
+				"e": map[string]interface{}{"f": int64(4)},
+			},
+		},
+	}, fields)
+}
@@ -0,0 +1,99 @@
+package slog
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/krostar/logger"
+)
+
+// objectValuer adapts a logger.ObjectMarshaler to a slog.LogValuer,
+// which slog renders as a group instead of using reflection.
+type objectValuer struct {
+	obj logger.ObjectMarshaler
+}
+
+// LogValue implements slog.LogValuer for objectValuer.
+// If the object fails to be marshaled, the error message is added
+// in the logger.FieldErrorKey attribute.
+func (v objectValuer) LogValue() slog.Value {
+	var enc attrsEncoder
+	if err := v.obj.MarshalLogObject(&enc); err != nil {
+		enc.attrs = append(enc.attrs, slog.String(logger.FieldErrorKey, err.Error()))
+	}
+	return slog.GroupValue(enc.attrs...)
+}
+
+// attrsEncoder is a logger.ObjectEncoder that stores the fields as attributes.
+type attrsEncoder struct {
+	attrs []slog.Attr
+}
+
+func (e *attrsEncoder) AddString(key string, value string) {
+	e.attrs = append(e.attrs, slog.String(key, value))
+}
+
+func (e *attrsEncoder) AddInt64(key string, value int64) {
+	e.attrs = append(e.attrs, slog.Int64(key, value))
+}
+
+func (e *attrsEncoder) AddFloat64(key string, value float64) {
+	e.attrs = append(e.attrs, slog.Float64(key, value))
+}
+
+func (e *attrsEncoder) AddBool(key string, value bool) {
+	e.attrs = append(e.attrs, slog.Bool(key, value))
+}
+
+func (e *attrsEncoder) AddDuration(key string, value time.Duration) {
+	e.attrs = append(e.attrs, slog.Duration(key, value))
+}
+
+func (e *attrsEncoder) AddTime(key string, value time.Time) {
+	e.attrs = append(e.attrs, slog.Time(key, value))
+}
+
+func (e *attrsEncoder) AddObject(key string, value logger.ObjectMarshaler) error {
+	var nested attrsEncoder
+	err := value.MarshalLogObject(&nested)
+	e.attrs = append(e.attrs, slog.Attr{Key: key, Value: slog.GroupValue(nested.attrs...)})
+	return err
+}
+
+func (e *attrsEncoder) AddAny(key string, value interface{}) error {
+	if obj, ok := value.(logger.ObjectMarshaler); ok {
+		return e.AddObject(key, obj)
+	}
+	e.attrs = append(e.attrs, slog.Any(key, value))
+	return nil
+}
+
+// convertValue returns the value slog should render for v.
+func convertValue(v interface{}) interface{} {
+	if obj, ok := v.(logger.ObjectMarshaler); ok {
+		return objectValuer{obj: obj}
+	}
+	return v
+}
+
+// convertKeysAndValues returns the key-value pairs with objects adapted for slog.
+// The provided slice is returned as is if it does not contain any object.
+func convertKeysAndValues(keysAndValues []interface{}) []interface{} {
+	var converted []interface{}
+
+	for i, value := range keysAndValues {
+		if _, ok := value.(logger.ObjectMarshaler); !ok {
+			continue
+		}
+		if converted == nil {
+			converted = make([]interface{}, len(keysAndValues))
+			copy(converted, keysAndValues)
+		}
+		converted[i] = convertValue(value)
+	}
+
+	if converted == nil {
+		return keysAndValues
+	}
+	return converted
+}
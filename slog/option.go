@@ -0,0 +1,261 @@
+package slog
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+
+	"github.com/krostar/logger"
+)
+
+const (
+	formatterJSON    = "json"
+	formatterConsole = "console"
+)
+
+type options struct {
+	level       *slog.LevelVar
+	output      io.Writer
+	formatter   string
+	withoutTime bool
+	handler     slog.Handler
+	caller      bool
+	stacktrace  *slog.Level
+	hooks       []logger.Hook
+}
+
+// Option defines a function signature to update configuration.
+type Option func(*options) error
+
+// WithConfig takes the logger configuration and applies it.
+func WithConfig(cfg logger.Config) Option {
+	var opts []Option
+
+	// verbosity
+	if lvl, err := logger.ParseLevel(cfg.Verbosity); err == nil {
+		opts = append(opts, WithLevel(lvl))
+	} else {
+		return func(o *options) error {
+			return fmt.Errorf("unable to apply level %q: %w", cfg.Verbosity, err)
+		}
+	}
+
+	// formatter
+	switch cfg.Formatter {
+	case formatterJSON:
+		opts = append(opts, WithJSONFormatter())
+	case formatterConsole:
+		opts = append(opts, WithConsoleFormatter(cfg.WithColor))
+	default:
+		return func(o *options) error {
+			return fmt.Errorf("unknown formatter %s", cfg.Formatter)
+		}
+	}
+
+	// outputs
+	opts = append(opts, withOutputStr(cfg.Output))
+
+	// caller and stacktrace
+	if cfg.WithCaller {
+		opts = append(opts, WithCaller())
+	}
+	if cfg.Stacktrace != "" {
+		if lvl, err := logger.ParseLevel(cfg.Stacktrace); err == nil {
+			opts = append(opts, WithStacktrace(lvl))
+		} else {
+			return func(o *options) error {
+				return fmt.Errorf("unable to apply stacktrace level %q: %w", cfg.Stacktrace, err)
+			}
+		}
+	}
+
+	// return all options
+	return func(o *options) error {
+		for _, opt := range opts {
+			if err := opt(o); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func withOutputStr(output string) Option {
+	opt := func(*options) error { return nil }
+
+	if output == "" {
+		return opt
+	}
+
+	switch output {
+	case "stdout":
+		opt = WithOutput(os.Stdout)
+	case "stderr":
+		opt = WithOutput(os.Stderr)
+	default:
+		f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+		if err != nil {
+			return func(o *options) error {
+				return fmt.Errorf("unable to open/create file %q: %w", output, err)
+			}
+		}
+		opt = WithOutput(f)
+
+		runtime.SetFinalizer(f, func(ff *os.File) {
+			_ = ff.Sync()
+			_ = ff.Close()
+		})
+	}
+
+	return opt
+}
+
+// WithLevel configures the minimum level of the logger.
+// It can later be updated with SetLevel.
+func WithLevel(level logger.Level) Option {
+	return func(o *options) error {
+		lvl, err := convertLevel(level)
+		if err != nil {
+			return fmt.Errorf("failed to convert level: %w", err)
+		}
+		o.level.Set(lvl)
+		return nil
+	}
+}
+
+// WithConsoleFormatter configures the format of the log output
+// to use "console" (cli) formatter.
+// Standard slog handlers do not support colors, colored is ignored.
+func WithConsoleFormatter(colored bool) Option {
+	return func(o *options) error {
+		o.formatter = formatterConsole
+		return nil
+	}
+}
+
+// WithJSONFormatter configures the format of the log output
+// to use "json" formatter.
+func WithJSONFormatter() Option {
+	return func(o *options) error {
+		o.formatter = formatterJSON
+		return nil
+	}
+}
+
+// WithOutput configures the writer used to write logs to.
+func WithOutput(writer io.Writer) Option {
+	return func(o *options) error {
+		o.output = writer
+		return nil
+	}
+}
+
+// WithHandler sets the slog handler used to write logs,
+// formatter and output options are then ignored.
+func WithHandler(handler slog.Handler) Option {
+	return func(o *options) error {
+		o.handler = handler
+		return nil
+	}
+}
+
+// WithoutTime configures the logger to log without time.
+// It only works with standard slog handlers.
+func WithoutTime() Option {
+	return func(o *options) error {
+		o.withoutTime = true
+		return nil
+	}
+}
+
+// WithCaller configures the logger to report the source of each entry.
+// The source is the first function outside of the logging packages,
+// see logger.CallerFrame. With WithHandler, the provided handler
+// must be configured to add the source.
+func WithCaller() Option {
+	return func(o *options) error {
+		o.caller = true
+		return nil
+	}
+}
+
+// WithHooks registers hooks fired with each written entry.
+func WithHooks(hooks ...logger.Hook) Option {
+	return func(o *options) error {
+		o.hooks = append(o.hooks, hooks...)
+		return nil
+	}
+}
+
+// WithStacktrace configures the logger to add the stack trace of the code
+// that logged each entry at or above the provided level.
+func WithStacktrace(level logger.Level) Option {
+	return func(o *options) error {
+		lvl, err := convertLevel(level)
+		if err != nil {
+			return fmt.Errorf("failed to convert level: %w", err)
+		}
+		o.stacktrace = &lvl
+		return nil
+	}
+}
+
+func (o *options) buildHandler() slog.Handler {
+	handler := o.buildBaseHandler()
+	if len(o.hooks) > 0 {
+		handler = &hookHandler{Handler: handler, hooks: o.hooks}
+	}
+	return handler
+}
+
+func (o *options) buildBaseHandler() slog.Handler {
+	if o.handler != nil {
+		return o.handler
+	}
+
+	handlerOpts := &slog.HandlerOptions{
+		AddSource:   o.caller,
+		Level:       o.level,
+		ReplaceAttr: o.replaceAttr,
+	}
+
+	if o.formatter == formatterConsole {
+		return slog.NewTextHandler(o.output, handlerOpts)
+	}
+	return slog.NewJSONHandler(o.output, handlerOpts)
+}
+
+func (o *options) replaceAttr(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return attr
+	}
+
+	switch attr.Key {
+	case slog.TimeKey:
+		if o.withoutTime {
+			return slog.Attr{}
+		}
+	case slog.LevelKey:
+		if lvl, ok := attr.Value.Any().(slog.Level); ok {
+			attr.Value = slog.StringValue(levelString(lvl))
+		}
+	}
+
+	return attr
+}
+
+// levelString returns the same representation as logger.Level.String.
+func levelString(lvl slog.Level) string {
+	switch {
+	case lvl < slog.LevelInfo:
+		return logger.LevelDebug.String()
+	case lvl < slog.LevelWarn:
+		return logger.LevelInfo.String()
+	case lvl < slog.LevelError:
+		return logger.LevelWarn.String()
+	default:
+		return logger.LevelError.String()
+	}
+}
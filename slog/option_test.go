@@ -0,0 +1,177 @@
+package slog
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func newOptions() options {
+	return options{
+		level:     new(slog.LevelVar),
+		output:    os.Stderr,
+		formatter: formatterJSON,
+	}
+}
+
+func Test_WithConfig_json(t *testing.T) {
+	o := newOptions()
+
+	err := WithConfig(logger.Config{
+		Verbosity: "error",
+		Formatter: "json",
+		WithColor: false,
+	})(&o)
+	require.NoError(t, err)
+
+	assert.Equal(t, slog.LevelError, o.level.Level())
+	assert.IsType(t, new(slog.JSONHandler), o.buildHandler())
+}
+
+func Test_WithConfig_console(t *testing.T) {
+	o := newOptions()
+
+	err := WithConfig(logger.Config{
+		Verbosity: "error",
+		Formatter: "console",
+		WithColor: true,
+	})(&o)
+	require.NoError(t, err)
+
+	assert.Equal(t, slog.LevelError, o.level.Level())
+	assert.IsType(t, new(slog.TextHandler), o.buildHandler())
+}
+
+func Test_WithConfig_caller(t *testing.T) {
+	o := newOptions()
+
+	err := WithConfig(logger.Config{
+		Verbosity:  "error",
+		Formatter:  "json",
+		WithCaller: true,
+		Stacktrace: "warn",
+	})(&o)
+	require.NoError(t, err)
+
+	assert.True(t, o.caller)
+	require.NotNil(t, o.stacktrace)
+	assert.Equal(t, slog.LevelWarn, *o.stacktrace)
+}
+
+func Test_WithConfig_error(t *testing.T) {
+	t.Run("unparsable level", func(t *testing.T) {
+		o := newOptions()
+
+		err := WithConfig(logger.Config{
+			Formatter: "json",
+			Verbosity: "boum",
+		})(&o)
+		require.Error(t, err)
+	})
+
+	t.Run("unparsable stacktrace level", func(t *testing.T) {
+		o := newOptions()
+
+		err := WithConfig(logger.Config{
+			Verbosity:  "error",
+			Formatter:  "json",
+			Stacktrace: "boum",
+		})(&o)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		o := newOptions()
+
+		err := WithConfig(logger.Config{
+			Verbosity: "error",
+			Formatter: "boum",
+		})(&o)
+		require.Error(t, err)
+	})
+
+	t.Run("output stdout", func(t *testing.T) {
+		o := newOptions()
+
+		err := WithConfig(logger.Config{
+			Formatter: "json",
+			Output:    "stdout",
+		})(&o)
+		require.NoError(t, err)
+		assert.Equal(t, os.Stdout, o.output)
+	})
+
+	t.Run("output stderr", func(t *testing.T) {
+		o := newOptions()
+
+		err := WithConfig(logger.Config{
+			Formatter: "json",
+			Output:    "stderr",
+		})(&o)
+		require.NoError(t, err)
+		assert.Equal(t, os.Stderr, o.output)
+	})
+}
+
+func Test_WithLevel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		o := newOptions()
+		err := WithLevel(logger.LevelError)(&o)
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelError, o.level.Level())
+	})
+	t.Run("fail", func(t *testing.T) {
+		o := newOptions()
+		err := WithLevel(logger.Level(42))(&o)
+		require.Error(t, err)
+	})
+}
+
+func Test_WithOutput(t *testing.T) {
+	var (
+		o         = newOptions()
+		_, writer = io.Pipe()
+	)
+
+	err := WithOutput(writer)(&o)
+
+	require.NoError(t, err)
+	assert.Equal(t, writer, o.output)
+}
+
+func Test_WithHandler(t *testing.T) {
+	var (
+		o       = newOptions()
+		handler = slog.NewTextHandler(io.Discard, nil)
+	)
+
+	err := WithHandler(handler)(&o)
+
+	require.NoError(t, err)
+	assert.Equal(t, handler, o.buildHandler())
+}
+
+func Test_WithoutTime(t *testing.T) {
+	var buf bytes.Buffer
+
+	o := newOptions()
+	o.output = &buf
+	require.NoError(t, WithoutTime()(&o))
+
+	slog.New(o.buildHandler()).Info("hello")
+	assert.Equal(t, `{"level":"info","msg":"hello"}`+"\n", buf.String())
+}
+
+func Test_WithStacktrace(t *testing.T) {
+	o := newOptions()
+	require.Error(t, WithStacktrace(logger.Level(42))(&o))
+	require.NoError(t, WithStacktrace(logger.LevelError)(&o))
+	assert.Equal(t, slog.LevelError, *o.stacktrace)
+}
@@ -0,0 +1,279 @@
+// Package slog implements the logger.Logger interface using the standard library log/slog implementation.
+package slog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/krostar/logger"
+)
+
+// levelQuiet is a level high enough to hide every log entry.
+const levelQuiet = slog.LevelError + 4
+
+// Slog implements Logger interface.
+type Slog struct {
+	log   *slog.Logger
+	level *slog.LevelVar
+	// lazy contains the attributes whose values are lazy, they are kept
+	// aside as slog handlers usually format attributes as soon as they are added.
+	lazy []interface{}
+	// name is added to each entry instead of being added as an attribute
+	// to avoid duplicated keys when Named is called more than once.
+	name string
+	// caller and stacktrace are set by WithCaller and WithStacktrace.
+	caller     bool
+	stacktrace *slog.Level
+}
+
+// New returns a new slog instance.
+func New(opts ...Option) (*Slog, error) {
+	o := options{
+		level:     new(slog.LevelVar),
+		output:    os.Stderr,
+		formatter: formatterJSON,
+	}
+
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, fmt.Errorf("unable to apply config: %w", err)
+		}
+	}
+
+	return &Slog{
+		log:        slog.New(o.buildHandler()),
+		level:      o.level,
+		caller:     o.caller,
+		stacktrace: o.stacktrace,
+	}, nil
+}
+
+func convertLevel(level logger.Level) (slog.Level, error) {
+	var slogLevel slog.Level
+	switch level {
+	case logger.LevelDebug:
+		slogLevel = slog.LevelDebug
+	case logger.LevelInfo:
+		slogLevel = slog.LevelInfo
+	case logger.LevelWarn:
+		slogLevel = slog.LevelWarn
+	case logger.LevelError:
+		slogLevel = slog.LevelError
+	case logger.LevelQuiet:
+		slogLevel = levelQuiet
+	default:
+		return slogLevel, errors.New("level conversion to slog level impossible")
+	}
+	return slogLevel, nil
+}
+
+// SetLevel applies a new level to a logger instance.
+func (l *Slog) SetLevel(level logger.Level) error {
+	lvl, err := convertLevel(level)
+	if err != nil {
+		return fmt.Errorf("unable to convert level: %w", err)
+	}
+	l.level.Set(lvl)
+	return nil
+}
+
+// Level implements Logger.Level for slog's logger.
+func (l *Slog) Level() logger.Level {
+	if lvl := l.level.Level(); lvl <= slog.LevelError {
+		return logger.SlogLevel(lvl)
+	}
+	return logger.LevelQuiet
+}
+
+// Enabled implements Logger.Enabled for slog's logger.
+func (l *Slog) Enabled(level logger.Level) bool {
+	lvl, err := convertLevel(level)
+	if err != nil || level == logger.LevelQuiet {
+		return false
+	}
+	return l.enabled(lvl)
+}
+
+// Debug implements Logger.Debug for slog's logger.
+func (l *Slog) Debug(args ...interface{}) { l.print(slog.LevelDebug, args) }
+
+// Debugf implements Logger.Debugf for slog's logger.
+func (l *Slog) Debugf(format string, args ...interface{}) { l.printf(slog.LevelDebug, format, args) }
+
+// Debugw implements Logger.Debugw for slog's logger.
+func (l *Slog) Debugw(msg string, keysAndValues ...interface{}) {
+	l.printw(slog.LevelDebug, msg, keysAndValues)
+}
+
+// Info implements Logger.Info for slog's logger.
+func (l *Slog) Info(args ...interface{}) { l.print(slog.LevelInfo, args) }
+
+// Infof implements Logger.Infof for slog's logger.
+func (l *Slog) Infof(format string, args ...interface{}) { l.printf(slog.LevelInfo, format, args) }
+
+// Infow implements Logger.Infow for slog's logger.
+func (l *Slog) Infow(msg string, keysAndValues ...interface{}) {
+	l.printw(slog.LevelInfo, msg, keysAndValues)
+}
+
+// Warn implements Logger.Warn for slog's logger.
+func (l *Slog) Warn(args ...interface{}) { l.print(slog.LevelWarn, args) }
+
+// Warnf implements Logger.Warnf for slog's logger.
+func (l *Slog) Warnf(format string, args ...interface{}) { l.printf(slog.LevelWarn, format, args) }
+
+// Warnw implements Logger.Warnw for slog's logger.
+func (l *Slog) Warnw(msg string, keysAndValues ...interface{}) {
+	l.printw(slog.LevelWarn, msg, keysAndValues)
+}
+
+// Error implements Logger.Error for slog's logger.
+func (l *Slog) Error(args ...interface{}) { l.print(slog.LevelError, args) }
+
+// Errorf implements Logger.Errorf for slog's logger.
+func (l *Slog) Errorf(format string, args ...interface{}) { l.printf(slog.LevelError, format, args) }
+
+// Errorw implements Logger.Errorw for slog's logger.
+func (l *Slog) Errorw(msg string, keysAndValues ...interface{}) {
+	l.printw(slog.LevelError, msg, keysAndValues)
+}
+
+// WithField implements Logger.WithField for slog's logger.
+func (l *Slog) WithField(key string, value interface{}) logger.Logger {
+	return l.withAttrs([]slog.Attr{slog.Any(key, convertValue(value))})
+}
+
+// WithFields implements Logger.WithFields for slog's logger.
+func (l *Slog) WithFields(fields map[string]interface{}) logger.Logger {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for key, value := range fields {
+		attrs = append(attrs, slog.Any(key, convertValue(value)))
+	}
+	return l.withAttrs(attrs)
+}
+
+// With implements Logger.With for slog's logger.
+func (l *Slog) With(fields ...logger.Field) logger.Logger {
+	fields = logger.ExpandErrorFields(fields)
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, field := range fields {
+		if attr, ok := convertField(field); ok {
+			attrs = append(attrs, attr)
+		}
+	}
+	return l.withAttrs(attrs)
+}
+
+// WithError implements Logger.WithError for slog's logger.
+func (l *Slog) WithError(err error) logger.Logger {
+	if err != nil {
+		return l.With(logger.ErrorFields(err)...)
+	}
+	return l
+}
+
+// Named implements Logger.Named for slog's logger.
+// The name is stored in the logger.FieldNameKey attribute.
+func (l *Slog) Named(name string) logger.Logger {
+	child := *l
+	child.name = logger.JoinNames(l.name, name)
+	return &child
+}
+
+func convertField(field logger.Field) (slog.Attr, bool) {
+	switch field.Type {
+	case logger.FieldTypeSkip:
+		return slog.Attr{}, false
+	case logger.FieldTypeString:
+		return slog.String(field.Key, field.String), true
+	case logger.FieldTypeInt64:
+		return slog.Int64(field.Key, field.Integer), true
+	case logger.FieldTypeBool:
+		return slog.Bool(field.Key, field.Integer == 1), true
+	case logger.FieldTypeDuration:
+		return slog.Duration(field.Key, time.Duration(field.Integer)), true
+	default:
+		return slog.Any(field.Key, convertValue(field.Value())), true
+	}
+}
+
+func (l *Slog) withAttrs(attrs []slog.Attr) *Slog {
+	var args, lazy []interface{}
+	for _, attr := range attrs {
+		if _, ok := attr.Value.Any().(*logger.LazyValue); ok {
+			lazy = append(lazy, attr)
+		} else {
+			args = append(args, attr)
+		}
+	}
+
+	if len(lazy) > 0 {
+		lazy = append(l.lazy[:len(l.lazy):len(l.lazy)], lazy...)
+	} else {
+		lazy = l.lazy
+	}
+
+	child := *l
+	child.log = l.log.With(args...)
+	child.lazy = lazy
+	return &child
+}
+
+func (l *Slog) enabled(lvl slog.Level) bool {
+	return lvl >= l.level.Level() && l.log.Enabled(context.Background(), lvl)
+}
+
+func (l *Slog) print(lvl slog.Level, args []interface{}) {
+	if l.enabled(lvl) {
+		l.write(lvl, fmt.Sprint(logger.ResolveLazyArgs(args)...), nil)
+	}
+}
+
+func (l *Slog) printf(lvl slog.Level, format string, args []interface{}) {
+	if l.enabled(lvl) {
+		l.write(lvl, fmt.Sprintf(format, logger.ResolveLazyArgs(args)...), nil)
+	}
+}
+
+func (l *Slog) printw(lvl slog.Level, msg string, keysAndValues []interface{}) {
+	if l.enabled(lvl) {
+		l.write(lvl, msg, convertKeysAndValues(keysAndValues))
+	}
+}
+
+// write logs the message along with the lazy attributes.
+func (l *Slog) write(lvl slog.Level, msg string, args []interface{}) {
+	if len(l.lazy) > 0 {
+		lazy := make([]interface{}, len(l.lazy), len(l.lazy)+len(args))
+		for i, attr := range l.lazy {
+			attr := attr.(slog.Attr)
+			lazy[i] = slog.Any(attr.Key, convertValue(logger.ResolveLazy(attr.Value.Any())))
+		}
+		args = append(lazy, args...)
+	}
+	if l.name != "" {
+		args = append([]interface{}{slog.String(logger.FieldNameKey, l.name)}, args...)
+	}
+	if l.stacktrace != nil && lvl >= *l.stacktrace {
+		if stack := logger.Stacktrace(); stack != "" {
+			args = append(args, slog.String(logger.FieldStacktraceKey, stack))
+		}
+	}
+
+	// the record is built here as slog would report
+	// this function as the source of the entry
+	var pc uintptr
+	if l.caller {
+		if frame, ok := logger.CallerFrame(); ok {
+			pc = frame.PC
+		}
+	}
+
+	record := slog.NewRecord(time.Now(), lvl, msg, pc)
+	record.Add(args...)
+	_ = l.log.Handler().Handle(context.Background(), record)
+}
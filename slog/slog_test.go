@@ -0,0 +1,359 @@
+package slog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	stdlog "log"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/krostar/logger"
+)
+
+func newDeterministicLogger(t *testing.T, opts ...Option) (*Slog, *bytes.Buffer) {
+	var buf bytes.Buffer
+
+	log, err := New(append([]Option{WithOutput(&buf), WithoutTime()}, opts...)...)
+	require.NoError(t, err)
+	return log, &buf
+}
+
+func decodeOutput(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	return output
+}
+
+func Test_SlogImplementLogger(t *testing.T) {
+	var i interface{} = new(Slog)
+	if _, ok := i.(logger.Logger); !ok {
+		t.Fatalf("expected %t to implement Logger", i)
+	}
+}
+
+func Test_New_opt_failure(t *testing.T) {
+	_, err := New(WithConfig(logger.Config{
+		Formatter: "boum",
+	}))
+	require.Error(t, err)
+}
+
+func Test_convertLevel(t *testing.T) {
+	tests := map[string]struct {
+		expectedFailure   bool
+		level             logger.Level
+		expectedSlogLevel slog.Level
+	}{
+		"debug": {
+			level:             logger.LevelDebug,
+			expectedSlogLevel: slog.LevelDebug,
+		}, "warn": {
+			level:             logger.LevelWarn,
+			expectedSlogLevel: slog.LevelWarn,
+		}, "info": {
+			level:             logger.LevelInfo,
+			expectedSlogLevel: slog.LevelInfo,
+		}, "error": {
+			level:             logger.LevelError,
+			expectedSlogLevel: slog.LevelError,
+		}, "quiet": {
+			level:             logger.LevelQuiet,
+			expectedSlogLevel: levelQuiet,
+		}, "failure": {
+			level:           logger.Level(42),
+			expectedFailure: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			slogLvl, err := convertLevel(test.level)
+			if test.expectedFailure {
+				require.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedSlogLevel, slogLvl)
+			}
+		})
+	}
+}
+
+func Test_RedirectStdLog(t *testing.T) {
+	log, buf := newDeterministicLogger(t)
+
+	restore := logger.RedirectStdLog(log, logger.LevelError)
+	stdlog.Println("i'm a log")
+	restore()
+
+	assert.Equal(t, map[string]interface{}{
+		"level":  "error",
+		"msg":    "i'm a log",
+		"stdlog": "unhandled call to standard log package",
+	}, decodeOutput(t, buf))
+}
+
+func TestSlog_SetLevel(t *testing.T) {
+	log, buf := newDeterministicLogger(t, WithLevel(logger.LevelDebug))
+	assert.Equal(t, slog.LevelDebug, log.level.Level())
+
+	t.Run("nominal", func(t *testing.T) {
+		err := log.SetLevel(logger.LevelWarn)
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelWarn, log.level.Level())
+
+		log.Info("info")
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("quiet", func(t *testing.T) {
+		err := log.SetLevel(logger.LevelQuiet)
+		require.NoError(t, err)
+
+		log.Error("error")
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := log.SetLevel(logger.Level(42))
+		require.Error(t, err)
+	})
+}
+
+func TestSlog_Log(t *testing.T) {
+	log, buf := newDeterministicLogger(t, WithLevel(logger.LevelDebug))
+
+	tests := map[string]struct {
+		logFunc       func(args ...interface{})
+		logFFunc      func(format string, args ...interface{})
+		expectedLevel string
+	}{
+		"debug": {logFunc: log.Debug, logFFunc: log.Debugf, expectedLevel: "debug"},
+		"info":  {logFunc: log.Info, logFFunc: log.Infof, expectedLevel: "info"},
+		"warn":  {logFunc: log.Warn, logFFunc: log.Warnf, expectedLevel: "warn"},
+		"error": {logFunc: log.Error, logFFunc: log.Errorf, expectedLevel: "error"},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			// tests can't be ran in parallel as they share the same logger
+			buf.Reset()
+			test.logFunc("hello", 42)
+			assert.Equal(t, map[string]interface{}{
+				"level": test.expectedLevel,
+				"msg":   "hello42",
+			}, decodeOutput(t, buf))
+
+			buf.Reset()
+			test.logFFunc("hello %d", 42)
+			assert.Equal(t, map[string]interface{}{
+				"level": test.expectedLevel,
+				"msg":   "hello 42",
+			}, decodeOutput(t, buf))
+		})
+	}
+}
+
+func TestSlog_WithField(t *testing.T) {
+	log, buf := newDeterministicLogger(t)
+	log.WithField("hello", "world").WithField("answer", 42).Warn("warn")
+
+	assert.Equal(t, map[string]interface{}{
+		"level":  "warn",
+		"msg":    "warn",
+		"hello":  "world",
+		"answer": float64(42),
+	}, decodeOutput(t, buf))
+}
+
+func TestSlog_WithFields(t *testing.T) {
+	log, buf := newDeterministicLogger(t)
+	log.
+		WithFields(map[string]interface{}{"hello": "world"}).
+		WithFields(map[string]interface{}{"answer": 42}).
+		Warn("warn")
+
+	assert.Equal(t, map[string]interface{}{
+		"level":  "warn",
+		"msg":    "warn",
+		"hello":  "world",
+		"answer": float64(42),
+	}, decodeOutput(t, buf))
+}
+
+func TestSlog_Named(t *testing.T) {
+	log, buf := newDeterministicLogger(t)
+	log.Named("db").WithField("hello", "world").Named("pool").Warn("warn")
+
+	assert.Equal(t, map[string]interface{}{
+		"level":             "warn",
+		"msg":               "warn",
+		"hello":             "world",
+		logger.FieldNameKey: "db.pool",
+	}, decodeOutput(t, buf))
+}
+
+func TestSlog_WithError(t *testing.T) {
+	log, buf := newDeterministicLogger(t)
+	log.
+		WithError(errors.New("eww1")).
+		WithError(fmt.Errorf("eww2: %w", errors.New("cause"))).
+		Warn("warn")
+
+	// slog handlers do not deduplicate keys, the last one wins once decoded
+	assert.Equal(t, map[string]interface{}{
+		"level":                   "warn",
+		"msg":                     "warn",
+		logger.FieldErrorKey:      "eww2: cause",
+		logger.FieldErrorTypeKey:  "*fmt.wrapError",
+		logger.FieldErrorChainKey: []interface{}{"cause"},
+	}, decodeOutput(t, buf))
+}
+
+func TestSlog_Logw(t *testing.T) {
+	log, buf := newDeterministicLogger(t, WithLevel(logger.LevelDebug))
+
+	tests := map[string]struct {
+		logWFunc      func(msg string, keysAndValues ...interface{})
+		expectedLevel string
+	}{
+		"debug": {logWFunc: log.Debugw, expectedLevel: "debug"},
+		"info":  {logWFunc: log.Infow, expectedLevel: "info"},
+		"warn":  {logWFunc: log.Warnw, expectedLevel: "warn"},
+		"error": {logWFunc: log.Errorw, expectedLevel: "error"},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			// tests can't be ran in parallel as they share the same logger
+			buf.Reset()
+			test.logWFunc("hello", "answer", 42)
+			assert.Equal(t, map[string]interface{}{
+				"level":  test.expectedLevel,
+				"msg":    "hello",
+				"answer": float64(42),
+			}, decodeOutput(t, buf))
+		})
+	}
+}
+
+func TestSlog_With(t *testing.T) {
+	log, buf := newDeterministicLogger(t)
+	log.
+		With(logger.String("hello", "world"), logger.Int("answer", 42)).
+		With(logger.Bool("ok", true), logger.Float64("pi", 3.14), logger.Err(nil)).
+		With(logger.Duration("duration", time.Second), logger.Any("list", []int{4, 2})).
+		With(logger.Err(fmt.Errorf("eww: %w", errors.New("cause")))).
+		Warn("warn")
+
+	assert.Equal(t, map[string]interface{}{
+		"level":                   "warn",
+		"msg":                     "warn",
+		"hello":                   "world",
+		"answer":                  float64(42),
+		"ok":                      true,
+		"pi":                      3.14,
+		"duration":                float64(time.Second),
+		"list":                    []interface{}{float64(4), float64(2)},
+		logger.FieldErrorKey:      "eww: cause",
+		logger.FieldErrorTypeKey:  "*fmt.wrapError",
+		logger.FieldErrorChainKey: []interface{}{"cause"},
+	}, decodeOutput(t, buf))
+}
+
+type user struct {
+	id       int64
+	password string
+}
+
+func (u user) MarshalLogObject(enc logger.ObjectEncoder) error {
+	enc.AddInt64("id", u.id)
+	return enc.AddObject("address", logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+		return enc.AddAny("city", "Paris")
+	}))
+}
+
+func TestSlog_object(t *testing.T) {
+	expectedUser := map[string]interface{}{
+		"id":      float64(42),
+		"address": map[string]interface{}{"city": "Paris"},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		log, buf := newDeterministicLogger(t)
+		u := user{id: 42, password: "secret"}
+		log.
+			WithField("field", u).
+			WithFields(map[string]interface{}{"fields": u}).
+			With(logger.Object("typed", u)).
+			WithField("lazy", logger.Lazy(func() interface{} { return u })).
+			Warnw("warn", "kv", u)
+
+		assert.Equal(t, map[string]interface{}{
+			"level":  "warn",
+			"msg":    "warn",
+			"field":  expectedUser,
+			"fields": expectedUser,
+			"typed":  expectedUser,
+			"lazy":   expectedUser,
+			"kv":     expectedUser,
+		}, decodeOutput(t, buf))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		log, buf := newDeterministicLogger(t, WithConsoleFormatter(false))
+		log.WithField("user", user{id: 42, password: "secret"}).Warn("warn")
+		assert.Equal(t, "level=warn msg=warn user.id=42 user.address.city=Paris\n", buf.String())
+	})
+}
+
+func TestSlog_Enabled(t *testing.T) {
+	log, _ := newDeterministicLogger(t, WithLevel(logger.LevelWarn))
+
+	assert.Equal(t, logger.LevelWarn, log.Level())
+	assert.False(t, log.Enabled(logger.LevelInfo))
+	assert.True(t, log.Enabled(logger.LevelWarn))
+	assert.True(t, log.Enabled(logger.LevelError))
+	assert.False(t, log.Enabled(logger.LevelQuiet))
+	assert.False(t, log.Enabled(logger.Level(42)))
+
+	require.NoError(t, log.SetLevel(logger.LevelQuiet))
+	assert.Equal(t, logger.LevelQuiet, log.Level())
+	assert.False(t, log.Enabled(logger.LevelError))
+}
+
+func TestSlog_lazy(t *testing.T) {
+	var calls int
+	lazy := logger.Lazy(func() interface{} {
+		calls++
+		return map[string]int{"answer": 42}
+	})
+
+	log, buf := newDeterministicLogger(t)
+	child := log.WithField("lazy", lazy).With(logger.Any("typed", lazy))
+
+	child.Debug("debug")
+	child.Debugf("debug %v", lazy)
+	child.Debugw("debug", "lazy", lazy)
+	require.Equal(t, 0, calls)
+
+	child.Warnf("warn %v", lazy)
+	assert.Equal(t, map[string]interface{}{
+		"level": "warn",
+		"msg":   "warn map[answer:42]",
+		"lazy":  map[string]interface{}{"answer": float64(42)},
+		"typed": map[string]interface{}{"answer": float64(42)},
+	}, decodeOutput(t, buf))
+	assert.Equal(t, 1, calls)
+}
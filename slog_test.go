@@ -0,0 +1,136 @@
+package logger
+
+import (
+	"context"
+	stdlog "log"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SlogLevel(t *testing.T) {
+	tests := map[slog.Level]Level{
+		slog.LevelDebug - 2: LevelDebug,
+		slog.LevelDebug:     LevelDebug,
+		slog.LevelInfo:      LevelInfo,
+		slog.LevelInfo + 2:  LevelInfo,
+		slog.LevelWarn:      LevelWarn,
+		slog.LevelError:     LevelError,
+		slog.LevelError + 4: LevelError,
+	}
+
+	for slogLevel, expectedLevel := range tests {
+		assert.Equal(t, expectedLevel, SlogLevel(slogLevel), "slog level %s", slogLevel)
+	}
+}
+
+func Test_Slog(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	Slog(log).With("hello", "world").Warn("warn", "answer", 42)
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, LevelWarn, log.Entries[0].Level)
+	assert.Equal(t, []interface{}{"warn"}, log.Entries[0].Args)
+	assert.Equal(t, map[string]interface{}{
+		"hello":  "world",
+		"answer": int64(42),
+	}, log.Entries[0].Fields)
+}
+
+func Test_SlogHandler_Enabled(t *testing.T) {
+	handler := SlogHandler(NewInMemory(LevelWarn))
+
+	assert.False(t, handler.Enabled(context.Background(), slog.LevelInfo))
+	assert.True(t, handler.Enabled(context.Background(), slog.LevelWarn))
+}
+
+func Test_SlogHandler_prefixedGroups(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	Slog(log).
+		With("a", 1).
+		WithGroup("g").
+		With("b", 2).
+		WithGroup("h").
+		Info("info", "c", 3, slog.Group("i", "d", 4), slog.Group("", "e", 5), slog.Group("empty"))
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"a":       int64(1),
+		"g.b":     int64(2),
+		"g.h.c":   int64(3),
+		"g.h.i.d": int64(4),
+		"g.h.e":   int64(5),
+	}, log.Entries[0].Fields)
+}
+
+func Test_SlogHandler_groupSeparator(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	Slog(log, WithSlogGroupSeparator("/")).WithGroup("g").Info("info", "a", 1)
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{"g/a": int64(1)}, log.Entries[0].Fields)
+}
+
+func Test_SlogHandler_nestedGroups(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	Slog(log, WithSlogNestedGroups()).
+		With("a", 1).
+		WithGroup("g").
+		With("b", 2).
+		WithGroup("h").
+		Info("info", "c", 3, slog.Group("i", "d", 4))
+
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"a": int64(1),
+		"g": map[string]interface{}{
+			"b": int64(2),
+			"h": map[string]interface{}{
+				"c": int64(3),
+				"i": map[string]interface{}{"d": int64(4)},
+			},
+		},
+	}, log.Entries[0].Fields)
+
+	t.Run("empty groups are omitted", func(t *testing.T) {
+		log.Reset()
+
+		Slog(log, WithSlogNestedGroups()).WithGroup("g").WithGroup("h").Info("info")
+
+		require.Len(t, log.Entries, 1)
+		assert.Empty(t, log.Entries[0].Fields)
+	})
+}
+
+func Test_RedirectSlog(t *testing.T) {
+	var (
+		log     = NewInMemory(LevelDebug)
+		oldLog  = slog.Default()
+		restore = RedirectSlog(log)
+	)
+
+	slog.Info("i'm a log", "answer", 42)
+	stdlog.Println("i'm a std log")
+
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, LevelInfo, log.Entries[0].Level)
+	assert.Equal(t, []interface{}{"i'm a log"}, log.Entries[0].Args)
+	assert.Equal(t, map[string]interface{}{"answer": int64(42)}, log.Entries[0].Fields)
+	assert.Equal(t, []interface{}{"i'm a std log"}, log.Entries[1].Args)
+
+	restore()
+	assert.Equal(t, oldLog, slog.Default())
+
+	output, err := CaptureOutput(func() {
+		slog.Info("i'm a log")
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "INFO i'm a log")
+	require.Len(t, log.Entries, 2)
+}
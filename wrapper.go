@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"fmt"
+)
+
+type entryKind uint8
+
+const (
+	entryKindPrint  entryKind = iota // Debug, Info, Warn, Error
+	entryKindPrintf                  // Debugf, Infof, Warnf, Errorf
+	entryKindPrintw                  // Debugw, Infow, Warnw, Errorw
+)
+
+// entry describes a single call to one of the logging methods
+// of a Logger, so it can be inspected, delayed or replayed.
+type entry struct {
+	level Level
+	kind  entryKind
+	// format is the template of printf calls, or the message of printw calls.
+	format string
+	// args are the arguments of the call, or the key-value pairs of printw calls.
+	args []interface{}
+}
+
+// writeTo logs the entry using the provided logger.
+func (e entry) writeTo(l Logger) {
+	switch e.kind {
+	case entryKindPrintf:
+		LogFAtLevelFunc(l, e.level)(e.format, e.args...)
+	case entryKindPrintw:
+		LogWAtLevelFunc(l, e.level)(e.format, e.args...)
+	default:
+		LogAtLevelFunc(l, e.level)(e.args...)
+	}
+}
+
+// message returns the message the entry will be written with.
+func (e entry) message() string {
+	switch e.kind {
+	case entryKindPrintf:
+		return fmt.Sprintf(e.format, ResolveLazyArgs(e.args)...)
+	case entryKindPrintw:
+		return e.format
+	default:
+		if len(e.args) == 1 {
+			if msg, ok := e.args[0].(string); ok {
+				return msg
+			}
+		}
+		return fmt.Sprint(ResolveLazyArgs(e.args)...)
+	}
+}
+
+// wrapper implements Logger on top of another logger. Entries are given to
+// handle instead of being directly logged, and loggers derived from the
+// wrapped one are given to wrap to keep the same behavior down the line.
+type wrapper struct {
+	Logger
+	handle func(next Logger, e entry)
+	wrap   func(next Logger) Logger
+}
+
+// Debug implements Logger for wrapper.
+func (w *wrapper) Debug(args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelDebug, kind: entryKindPrint, args: args})
+}
+
+// Debugf implements Logger for wrapper.
+func (w *wrapper) Debugf(format string, args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelDebug, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Debugw implements Logger for wrapper.
+func (w *wrapper) Debugw(msg string, keysAndValues ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelDebug, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// Info implements Logger for wrapper.
+func (w *wrapper) Info(args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelInfo, kind: entryKindPrint, args: args})
+}
+
+// Infof implements Logger for wrapper.
+func (w *wrapper) Infof(format string, args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelInfo, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Infow implements Logger for wrapper.
+func (w *wrapper) Infow(msg string, keysAndValues ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelInfo, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// Warn implements Logger for wrapper.
+func (w *wrapper) Warn(args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelWarn, kind: entryKindPrint, args: args})
+}
+
+// Warnf implements Logger for wrapper.
+func (w *wrapper) Warnf(format string, args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelWarn, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Warnw implements Logger for wrapper.
+func (w *wrapper) Warnw(msg string, keysAndValues ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelWarn, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// Error implements Logger for wrapper.
+func (w *wrapper) Error(args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelError, kind: entryKindPrint, args: args})
+}
+
+// Errorf implements Logger for wrapper.
+func (w *wrapper) Errorf(format string, args ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelError, kind: entryKindPrintf, format: format, args: args})
+}
+
+// Errorw implements Logger for wrapper.
+func (w *wrapper) Errorw(msg string, keysAndValues ...interface{}) {
+	w.handle(w.Logger, entry{level: LevelError, kind: entryKindPrintw, format: msg, args: keysAndValues})
+}
+
+// WithField implements Logger for wrapper.
+func (w *wrapper) WithField(key string, value interface{}) Logger {
+	return w.wrap(w.Logger.WithField(key, value))
+}
+
+// WithFields implements Logger for wrapper.
+func (w *wrapper) WithFields(fields map[string]interface{}) Logger {
+	return w.wrap(w.Logger.WithFields(fields))
+}
+
+// With implements Logger for wrapper.
+func (w *wrapper) With(fields ...Field) Logger {
+	return w.wrap(w.Logger.With(fields...))
+}
+
+// WithError implements Logger for wrapper.
+func (w *wrapper) WithError(err error) Logger {
+	return w.wrap(w.Logger.WithError(err))
+}
+
+// Named implements Logger for wrapper.
+func (w *wrapper) Named(name string) Logger {
+	return w.wrap(w.Logger.Named(name))
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_entry_writeTo(t *testing.T) {
+	log := NewInMemory(LevelDebug)
+
+	entry{level: LevelInfo, kind: entryKindPrint, args: []interface{}{"hello", 42}}.writeTo(log)
+	entry{level: LevelWarn, kind: entryKindPrintf, format: "hello %d", args: []interface{}{42}}.writeTo(log)
+	entry{level: LevelError, kind: entryKindPrintw, format: "hello", args: []interface{}{"answer", 42}}.writeTo(log)
+
+	require.Len(t, log.Entries, 3)
+	assert.Equal(t, InMemoryEntry{
+		Level:  LevelInfo,
+		Args:   []interface{}{"hello", 42},
+		Fields: map[string]interface{}{},
+	}, log.Entries[0])
+	assert.Equal(t, InMemoryEntry{
+		Level:  LevelWarn,
+		Format: "hello %d",
+		Args:   []interface{}{42},
+		Fields: map[string]interface{}{},
+	}, log.Entries[1])
+	assert.Equal(t, InMemoryEntry{
+		Level:  LevelError,
+		Args:   []interface{}{"hello"},
+		Fields: map[string]interface{}{"answer": 42},
+	}, log.Entries[2])
+}
+
+func Test_entry_message(t *testing.T) {
+	lazy := Lazy(func() interface{} { return 42 })
+
+	assert.Equal(t, "hello", entry{kind: entryKindPrint, args: []interface{}{"hello"}}.message())
+	assert.Equal(t, "hello42", entry{kind: entryKindPrint, args: []interface{}{"hello", lazy}}.message())
+	assert.Equal(t, "hello 42", entry{kind: entryKindPrintf, format: "hello %d", args: []interface{}{lazy}}.message())
+	assert.Equal(t, "hello", entry{kind: entryKindPrintw, format: "hello", args: []interface{}{"answer", 42}}.message())
+}
+
+func Test_wrapper(t *testing.T) {
+	var (
+		log     = NewInMemory(LevelDebug)
+		entries []entry
+	)
+
+	var wrap func(Logger) Logger
+	wrap = func(next Logger) Logger {
+		return &wrapper{
+			Logger: next,
+			handle: func(next Logger, e entry) {
+				entries = append(entries, e)
+				e.writeTo(next)
+			},
+			wrap: wrap,
+		}
+	}
+
+	wrapped := wrap(log)
+	wrapped.Debug("debug")
+	wrapped.Infof("info %d", 42)
+	wrapped.Warnw("warn", "answer", 42)
+	wrapped.WithField("a", 1).Error("error")
+	wrapped.WithFields(map[string]interface{}{"b": 2}).Errorf("error")
+	wrapped.With(Int("c", 3)).Errorw("error")
+	wrapped.WithError(errors.New("eww")).Error("error")
+	wrapped.Named("name").Error("error")
+
+	require.Len(t, entries, 8)
+	require.Len(t, log.Entries, 8)
+	assert.Equal(t, entry{level: LevelDebug, kind: entryKindPrint, args: []interface{}{"debug"}}, entries[0])
+	assert.Equal(t, entry{level: LevelInfo, kind: entryKindPrintf, format: "info %d", args: []interface{}{42}}, entries[1])
+	assert.Equal(t, entry{level: LevelWarn, kind: entryKindPrintw, format: "warn", args: []interface{}{"answer", 42}}, entries[2])
+	assert.Equal(t, map[string]interface{}{"a": 1}, log.Entries[3].Fields)
+	assert.Equal(t, map[string]interface{}{"b": 2}, log.Entries[4].Fields)
+	assert.Equal(t, map[string]interface{}{"c": int64(3)}, log.Entries[5].Fields)
+	assert.Contains(t, log.Entries[6].Fields, FieldErrorKey)
+	assert.Equal(t, map[string]interface{}{FieldNameKey: "name"}, log.Entries[7].Fields)
+
+	require.NoError(t, wrapped.SetLevel(LevelError))
+	assert.Equal(t, LevelError, log.Level())
+	assert.Equal(t, LevelError, wrapped.Level())
+	assert.False(t, wrapped.Enabled(LevelWarn))
+}
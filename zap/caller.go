@@ -0,0 +1,48 @@
+package zap
+
+import (
+	"go.uber.org/zap/zapcore"
+
+	"github.com/krostar/logger"
+)
+
+// callerCore sets the caller and the stack trace of the entries, as zap's own
+// caller skip can't know how many wrappers are between the caller and zap.
+type callerCore struct {
+	zapcore.Core
+	caller     bool
+	stacktrace *zapcore.Level
+}
+
+// With implements zapcore.Core for callerCore.
+func (c *callerCore) With(fields []zapcore.Field) zapcore.Core {
+	return &callerCore{Core: c.Core.With(fields), caller: c.caller, stacktrace: c.stacktrace}
+}
+
+// Check implements zapcore.Core for callerCore. The wrapped core decides
+// whether the entry is written, the caller and the stack trace are only
+// computed for entries that are.
+func (c *callerCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	ce = c.Core.Check(ent, ce)
+	if ce == nil {
+		return nil
+	}
+
+	if c.caller {
+		if frame, ok := logger.CallerFrame(); ok {
+			ce.Entry.Caller = zapcore.EntryCaller{
+				Defined:  true,
+				PC:       frame.PC,
+				File:     frame.File,
+				Line:     frame.Line,
+				Function: frame.Function,
+			}
+		}
+	}
+
+	if c.stacktrace != nil && ce.Entry.Level >= *c.stacktrace {
+		ce.Entry.Stack = logger.Stacktrace()
+	}
+
+	return ce
+}
@@ -0,0 +1,88 @@
+package zap
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/krostar/logger"
+)
+
+func TestZap_caller(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log, _, err := New(WithoutTime(), WithCaller(), WithStacktrace(logger.LevelError))
+		require.NoError(t, err)
+
+		// the caller must be found through any number of wrappers
+		log = logger.NewSampler(logger.NewRedactor(log)).WithField("hello", "world")
+		log.Warn("warn")
+		log.Error("error")
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(outputRaw), "\n")
+	require.Len(t, lines, 2)
+
+	var warn, errorOutput map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &warn))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &errorOutput))
+
+	assert.Contains(t, warn["caller"], "zap/caller_test.go:")
+	assert.Equal(t, "github.com/krostar/logger/zap.TestZap_caller.func1", warn["func"])
+	assert.NotContains(t, warn, logger.FieldStacktraceKey)
+
+	require.Contains(t, errorOutput, logger.FieldStacktraceKey)
+	assert.True(t, strings.HasPrefix(errorOutput[logger.FieldStacktraceKey].(string), "github.com/krostar/logger/zap.TestZap_caller.func1\n"))
+}
+
+func TestZap_caller_sampling(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		cfg := zap.NewProductionConfig()
+		cfg.OutputPaths = []string{"stdout"}
+		cfg.Sampling = &zap.SamplingConfig{Initial: 1, Thereafter: 1000}
+
+		log, _, err := New(WithZapConfig(cfg), WithCaller(), WithStacktrace(logger.LevelInfo))
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			log.Info("sampled")
+		}
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(outputRaw), "\n")
+	require.Len(t, lines, 1, "zap sampling should still apply")
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &output))
+	assert.Contains(t, output["caller"], "zap/caller_test.go:")
+	assert.True(t, strings.HasPrefix(output["stacktrace"].(string), "github.com/krostar/logger/zap.TestZap_caller_sampling.func1\n"))
+}
+
+func TestZap_zapCaller(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		cfg := zap.NewProductionConfig()
+		cfg.OutputPaths = []string{"stdout"}
+
+		log, _, err := New(WithZapConfig(cfg))
+		require.NoError(t, err)
+
+		log.Info("info")
+		log.WithField("lazy", logger.Lazy(func() interface{} { return 42 })).Warnw("warn")
+		log.Named("db").Errorf("error")
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(outputRaw), "\n")
+	require.Len(t, lines, 3)
+
+	for _, line := range lines {
+		var output map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &output))
+		assert.Contains(t, output["caller"], "zap/caller_test.go:", "zap own caller reporting should skip the Zap methods")
+	}
+}
@@ -0,0 +1,76 @@
+package zap
+
+import (
+	"go.uber.org/zap/zapcore"
+
+	"github.com/krostar/logger"
+)
+
+// hookCore fires hooks with the entries written by the core it wraps,
+// it keeps the fields added to the core as zap cores do not expose them.
+// Like zapcore.RegisterHooks, it registers itself downstream of the
+// wrapped core, which decides whether the entry is written.
+type hookCore struct {
+	zapcore.Core
+	hooks  []logger.Hook
+	fields []zapcore.Field
+}
+
+// With implements zapcore.Core for hookCore.
+func (c *hookCore) With(fields []zapcore.Field) zapcore.Core {
+	return &hookCore{
+		Core:   c.Core.With(fields),
+		hooks:  c.hooks,
+		fields: append(c.fields[:len(c.fields):len(c.fields)], fields...),
+	}
+}
+
+// Check implements zapcore.Core for hookCore.
+func (c *hookCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if downstream := c.Core.Check(ent, ce); downstream != nil {
+		return downstream.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write implements zapcore.Core for hookCore, the wrapped
+// core has registered itself to write the entry.
+func (c *hookCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(enc)
+	}
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+	if ent.LoggerName != "" {
+		enc.Fields[logger.FieldNameKey] = ent.LoggerName
+	}
+
+	entry := logger.HookEntry{
+		Time:    ent.Time,
+		Level:   convertZapLevel(ent.Level),
+		Message: ent.Message,
+		Fields:  enc.Fields,
+	}
+	for _, hook := range c.hooks {
+		hook.Fire(entry)
+	}
+
+	return nil
+}
+
+// convertZapLevel returns the level of the zap entry,
+// levels above error are considered as errors.
+func convertZapLevel(level zapcore.Level) logger.Level {
+	switch {
+	case level <= zapcore.DebugLevel:
+		return logger.LevelDebug
+	case level == zapcore.InfoLevel:
+		return logger.LevelInfo
+	case level == zapcore.WarnLevel:
+		return logger.LevelWarn
+	default:
+		return logger.LevelError
+	}
+}
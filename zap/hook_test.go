@@ -0,0 +1,77 @@
+package zap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/krostar/logger"
+)
+
+func Test_WithHooks(t *testing.T) {
+	var entries []logger.HookEntry
+
+	log, _, err := New(WithOutputPaths(nil), WithHooks(logger.HookFunc(func(entry logger.HookEntry) {
+		entries = append(entries, entry)
+	})))
+	require.NoError(t, err)
+
+	child := log.Named("db").
+		WithField("lazy", logger.Lazy(func() interface{} { return 42 })).
+		With(logger.Object("object", logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+			enc.AddString("hello", "world")
+			return nil
+		})))
+	child.Debug("debug")
+	child.Warnf("warn %d", 42)
+	child.Errorw("error", "hello", "world")
+
+	require.Len(t, entries, 2)
+	assert.False(t, entries[0].Time.IsZero())
+	assert.Equal(t, logger.LevelWarn, entries[0].Level)
+	assert.Equal(t, "warn 42", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"lazy":              int64(42),
+		"object":            map[string]interface{}{"hello": "world"},
+		logger.FieldNameKey: "db",
+	}, entries[0].Fields)
+	assert.Equal(t, logger.LevelError, entries[1].Level)
+	assert.Equal(t, "error", entries[1].Message)
+	assert.Equal(t, "world", entries[1].Fields["hello"])
+}
+
+func Test_convertZapLevel(t *testing.T) {
+	tests := map[zapcore.Level]logger.Level{
+		zapcore.DebugLevel:  logger.LevelDebug,
+		zapcore.InfoLevel:   logger.LevelInfo,
+		zapcore.WarnLevel:   logger.LevelWarn,
+		zapcore.ErrorLevel:  logger.LevelError,
+		zapcore.DPanicLevel: logger.LevelError,
+		zapcore.FatalLevel:  logger.LevelError,
+	}
+	for zapLevel, expectedLevel := range tests {
+		assert.Equal(t, expectedLevel, convertZapLevel(zapLevel), "zap level %s", zapLevel)
+	}
+}
+
+func Test_WithHooks_sampling(t *testing.T) {
+	var entries []logger.HookEntry
+
+	cfg := zap.NewProductionConfig()
+	cfg.OutputPaths = nil
+	cfg.Sampling = &zap.SamplingConfig{Initial: 1, Thereafter: 1000}
+
+	log, _, err := New(WithZapConfig(cfg), WithHooks(logger.HookFunc(func(entry logger.HookEntry) {
+		entries = append(entries, entry)
+	})))
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		log.Info("sampled")
+	}
+
+	assert.Len(t, entries, 1, "zap sampling should still apply")
+}
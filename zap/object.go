@@ -0,0 +1,90 @@
+package zap
+
+import (
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/krostar/logger"
+)
+
+// objectMarshaler adapts a logger.ObjectMarshaler to a zapcore.ObjectMarshaler,
+// which zap encodes as a nested object instead of using reflection.
+type objectMarshaler struct {
+	obj logger.ObjectMarshaler
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler for objectMarshaler.
+func (m objectMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return m.obj.MarshalLogObject(objectEncoder{ObjectEncoder: enc})
+}
+
+// objectEncoder adapts a zapcore.ObjectEncoder to a logger.ObjectEncoder.
+type objectEncoder struct {
+	zapcore.ObjectEncoder
+}
+
+// AddObject implements logger.ObjectEncoder for objectEncoder.
+func (e objectEncoder) AddObject(key string, value logger.ObjectMarshaler) error {
+	return e.ObjectEncoder.AddObject(key, objectMarshaler{obj: value})
+}
+
+// AddAny implements logger.ObjectEncoder for objectEncoder.
+func (e objectEncoder) AddAny(key string, value interface{}) error {
+	if obj, ok := value.(logger.ObjectMarshaler); ok {
+		return e.AddObject(key, obj)
+	}
+	return e.ObjectEncoder.AddReflected(key, value)
+}
+
+// convertValue returns the value zap should encode for v.
+func convertValue(v interface{}) interface{} {
+	if obj, ok := v.(logger.ObjectMarshaler); ok {
+		return objectMarshaler{obj: obj}
+	}
+	return v
+}
+
+// resolveKeysAndValues returns the key-value pairs with lazy values computed
+// and objects adapted for zap. Keys that are not strings are converted using
+// fmt.Sprint and a value without a key is set in the logger.FieldBadKey field,
+// like logger.KeysAndValuesToFields does, as zap would drop them. The provided
+// slice is returned as is if there is nothing to change.
+func resolveKeysAndValues(keysAndValues []interface{}) []interface{} {
+	var resolved []interface{}
+
+	set := func(i int, value interface{}) {
+		if resolved == nil {
+			resolved = make([]interface{}, len(keysAndValues), len(keysAndValues)+1)
+			copy(resolved, keysAndValues)
+		}
+		resolved[i] = value
+	}
+
+	for i, value := range keysAndValues {
+		if i%2 == 0 && i < len(keysAndValues)-1 {
+			if _, ok := value.(string); !ok {
+				set(i, fmt.Sprint(value))
+			}
+			continue
+		}
+
+		switch value.(type) {
+		case *logger.LazyValue, logger.ObjectMarshaler:
+			set(i, convertValue(logger.ResolveLazy(value)))
+		}
+	}
+
+	if last := len(keysAndValues) - 1; last%2 == 0 {
+		if resolved == nil {
+			set(last, keysAndValues[last])
+		}
+		value := resolved[last]
+		resolved = append(resolved[:last], logger.FieldBadKey, value)
+	}
+
+	if resolved == nil {
+		return keysAndValues
+	}
+	return resolved
+}
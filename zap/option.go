@@ -10,8 +10,11 @@ import (
 )
 
 type config struct {
-	Level zapcore.Level
-	Zap   zap.Config
+	Level      zapcore.Level
+	Zap        zap.Config
+	Caller     bool
+	Stacktrace *zapcore.Level
+	Hooks      []logger.Hook
 }
 
 // Option defines a function signature to update configuration.
@@ -47,6 +50,20 @@ func WithConfig(cfg logger.Config) Option {
 		opts = append(opts, WithOutputPaths([]string{cfg.Output}))
 	}
 
+	// caller and stacktrace
+	if cfg.WithCaller {
+		opts = append(opts, WithCaller())
+	}
+	if cfg.Stacktrace != "" {
+		if lvl, err := logger.ParseLevel(cfg.Stacktrace); err == nil {
+			opts = append(opts, WithStacktrace(lvl))
+		} else {
+			return func(c *config) error {
+				return fmt.Errorf("unable to apply stacktrace level %q: %w", cfg.Stacktrace, err)
+			}
+		}
+	}
+
 	return func(c *config) error {
 		for _, opt := range opts {
 			if err := opt(c); err != nil {
@@ -118,3 +135,34 @@ func WithoutTime() Option {
 		return nil
 	}
 }
+
+// WithCaller configures the logger to add the file, line and function
+// of the code that logged each entry. The caller is the first function
+// outside of the logging packages, see logger.CallerFrame.
+func WithCaller() Option {
+	return func(c *config) error {
+		c.Caller = true
+		return nil
+	}
+}
+
+// WithHooks registers hooks fired with each written entry.
+func WithHooks(hooks ...logger.Hook) Option {
+	return func(c *config) error {
+		c.Hooks = append(c.Hooks, hooks...)
+		return nil
+	}
+}
+
+// WithStacktrace configures the logger to add the stack trace of the code
+// that logged each entry at or above the provided level.
+func WithStacktrace(level logger.Level) Option {
+	return func(c *config) error {
+		lvl, err := convertLevel(level)
+		if err != nil {
+			return err
+		}
+		c.Stacktrace = &lvl
+		return nil
+	}
+}
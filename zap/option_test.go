@@ -42,6 +42,32 @@ func Test_WithConfig(t *testing.T) {
 		assert.Equal(t, []string{"yolo"}, cfg.Zap.OutputPaths)
 	})
 
+	t.Run("success with caller and stacktrace", func(t *testing.T) {
+		var cfg config
+		err := WithConfig(logger.Config{
+			Verbosity:  "info",
+			Formatter:  "json",
+			WithCaller: true,
+			Stacktrace: "warn",
+		})(&cfg)
+
+		require.NoError(t, err)
+		assert.True(t, cfg.Caller)
+		require.NotNil(t, cfg.Stacktrace)
+		assert.Equal(t, zapcore.WarnLevel, *cfg.Stacktrace)
+	})
+
+	t.Run("unparsable stacktrace level", func(t *testing.T) {
+		var cfg config
+
+		err := WithConfig(logger.Config{
+			Verbosity:  "info",
+			Formatter:  "json",
+			Stacktrace: "boum",
+		})(&cfg)
+		require.Error(t, err)
+	})
+
 	t.Run("unparsable level", func(t *testing.T) {
 		var cfg config
 
@@ -121,3 +147,10 @@ func Test_WithZapConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, zapCfg, cfg.Zap)
 }
+
+func Test_WithStacktrace(t *testing.T) {
+	var cfg config
+	require.Error(t, WithStacktrace(logger.Level(42))(&cfg))
+	require.NoError(t, WithStacktrace(logger.LevelError)(&cfg))
+	assert.Equal(t, zapcore.ErrorLevel, *cfg.Stacktrace)
+}
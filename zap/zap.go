@@ -4,6 +4,8 @@ package zap
 import (
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -15,6 +17,9 @@ import (
 type Zap struct {
 	*zap.SugaredLogger
 	level *zap.AtomicLevel
+	// lazy contains the key-value pairs of fields whose values are lazy,
+	// they are kept aside as zap encodes fields as soon as they are added.
+	lazy []interface{}
 }
 
 // New returns a new zap instance.
@@ -30,6 +35,7 @@ func New(opts ...Option) (logger.Logger, func() error, error) {
 			Encoding:          "json",
 			EncoderConfig: zapcore.EncoderConfig{
 				MessageKey: "msg",
+				NameKey:    logger.FieldNameKey,
 				LineEnding: zapcore.DefaultLineEnding,
 
 				LevelKey:    "lvl",
@@ -39,8 +45,10 @@ func New(opts ...Option) (logger.Logger, func() error, error) {
 				EncodeTime:     zapcore.ISO8601TimeEncoder,
 				EncodeDuration: zapcore.SecondsDurationEncoder,
 
-				CallerKey:    "caller",
-				EncodeCaller: zapcore.ShortCallerEncoder,
+				CallerKey:     "caller",
+				FunctionKey:   "func",
+				EncodeCaller:  zapcore.ShortCallerEncoder,
+				StacktraceKey: logger.FieldStacktraceKey,
 			},
 		},
 	}
@@ -54,11 +62,32 @@ func New(opts ...Option) (logger.Logger, func() error, error) {
 	atomiclevel := zap.NewAtomicLevelAt(config.Level)
 	config.Zap.Level = atomiclevel
 
-	logger, err := config.Zap.Build()
+	// zap would otherwise override the caller and the stack trace set by callerCore
+	if config.Caller {
+		config.Zap.DisableCaller = true
+	}
+	if config.Stacktrace != nil {
+		config.Zap.DisableStacktrace = true
+	}
+
+	// the methods of Zap add a frame between the caller and the sugared logger
+	logger, err := config.Zap.Build(zap.AddCallerSkip(1))
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to create logger: %w", err)
 	}
 
+	if config.Caller || config.Stacktrace != nil {
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return &callerCore{Core: core, caller: config.Caller, stacktrace: config.Stacktrace}
+		}))
+	}
+
+	if len(config.Hooks) > 0 {
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return &hookCore{Core: core, hooks: config.Hooks}
+		}))
+	}
+
 	return &Zap{
 		level:         &atomiclevel,
 		SugaredLogger: logger.Sugar(),
@@ -82,6 +111,32 @@ func convertLevel(level logger.Level) (zapcore.Level, error) {
 	return zapLevel, nil
 }
 
+func convertField(field logger.Field) zap.Field {
+	switch field.Type {
+	case logger.FieldTypeSkip:
+		return zap.Skip()
+	case logger.FieldTypeString:
+		return zap.String(field.Key, field.String)
+	case logger.FieldTypeInt64:
+		return zap.Int64(field.Key, field.Integer)
+	case logger.FieldTypeFloat64:
+		return zap.Float64(field.Key, math.Float64frombits(uint64(field.Integer)))
+	case logger.FieldTypeBool:
+		return zap.Bool(field.Key, field.Integer == 1)
+	case logger.FieldTypeDuration:
+		return zap.Duration(field.Key, time.Duration(field.Integer))
+	case logger.FieldTypeTime:
+		return zap.Field{Key: field.Key, Type: zapcore.TimeType, Integer: field.Integer, Interface: field.Interface}
+	case logger.FieldTypeError:
+		if err, ok := field.Interface.(error); ok {
+			return zap.String(field.Key, err.Error())
+		}
+		return zap.Any(field.Key, field.Interface)
+	default:
+		return zap.Any(field.Key, convertValue(field.Interface))
+	}
+}
+
 // SetLevel applies a new level to a logger instance.
 func (l *Zap) SetLevel(level logger.Level) error {
 	zapLevel, err := convertLevel(level)
@@ -92,31 +147,185 @@ func (l *Zap) SetLevel(level logger.Level) error {
 	return nil
 }
 
-// WithField implements Logger.WithField for Zap logger.
-func (l *Zap) WithField(key string, value interface{}) logger.Logger {
+// Level implements Logger.Level for Zap logger.
+func (l *Zap) Level() logger.Level {
+	for _, lvl := range []logger.Level{logger.LevelDebug, logger.LevelInfo, logger.LevelWarn, logger.LevelError} {
+		if l.Enabled(lvl) {
+			return lvl
+		}
+	}
+	return logger.LevelQuiet
+}
+
+// Enabled implements Logger.Enabled for Zap logger.
+func (l *Zap) Enabled(level logger.Level) bool {
+	zapLevel, err := convertLevel(level)
+	if err != nil {
+		return false
+	}
+	if l.level != nil {
+		return l.level.Enabled(zapLevel)
+	}
+	return l.Desugar().Core().Enabled(zapLevel)
+}
+
+// Debug implements Logger.Debug for Zap logger.
+func (l *Zap) Debug(args ...interface{}) {
+	if l.Enabled(logger.LevelDebug) {
+		l.sugar().Debug(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Debugf implements Logger.Debugf for Zap logger.
+func (l *Zap) Debugf(format string, args ...interface{}) {
+	if l.Enabled(logger.LevelDebug) {
+		l.sugar().Debugf(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Debugw implements Logger.Debugw for Zap logger.
+func (l *Zap) Debugw(msg string, keysAndValues ...interface{}) {
+	if l.Enabled(logger.LevelDebug) {
+		l.sugar().Debugw(msg, resolveKeysAndValues(keysAndValues)...)
+	}
+}
+
+// Info implements Logger.Info for Zap logger.
+func (l *Zap) Info(args ...interface{}) {
+	if l.Enabled(logger.LevelInfo) {
+		l.sugar().Info(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Infof implements Logger.Infof for Zap logger.
+func (l *Zap) Infof(format string, args ...interface{}) {
+	if l.Enabled(logger.LevelInfo) {
+		l.sugar().Infof(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Infow implements Logger.Infow for Zap logger.
+func (l *Zap) Infow(msg string, keysAndValues ...interface{}) {
+	if l.Enabled(logger.LevelInfo) {
+		l.sugar().Infow(msg, resolveKeysAndValues(keysAndValues)...)
+	}
+}
+
+// Warn implements Logger.Warn for Zap logger.
+func (l *Zap) Warn(args ...interface{}) {
+	if l.Enabled(logger.LevelWarn) {
+		l.sugar().Warn(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Warnf implements Logger.Warnf for Zap logger.
+func (l *Zap) Warnf(format string, args ...interface{}) {
+	if l.Enabled(logger.LevelWarn) {
+		l.sugar().Warnf(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Warnw implements Logger.Warnw for Zap logger.
+func (l *Zap) Warnw(msg string, keysAndValues ...interface{}) {
+	if l.Enabled(logger.LevelWarn) {
+		l.sugar().Warnw(msg, resolveKeysAndValues(keysAndValues)...)
+	}
+}
+
+// Error implements Logger.Error for Zap logger.
+func (l *Zap) Error(args ...interface{}) {
+	if l.Enabled(logger.LevelError) {
+		l.sugar().Error(logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Errorf implements Logger.Errorf for Zap logger.
+func (l *Zap) Errorf(format string, args ...interface{}) {
+	if l.Enabled(logger.LevelError) {
+		l.sugar().Errorf(format, logger.ResolveLazyArgs(args)...)
+	}
+}
+
+// Errorw implements Logger.Errorw for Zap logger.
+func (l *Zap) Errorw(msg string, keysAndValues ...interface{}) {
+	if l.Enabled(logger.LevelError) {
+		l.sugar().Errorw(msg, resolveKeysAndValues(keysAndValues)...)
+	}
+}
+
+// sugar returns the sugared logger with all lazy fields computed.
+func (l *Zap) sugar() *zap.SugaredLogger {
+	if len(l.lazy) == 0 {
+		return l.SugaredLogger
+	}
+	return l.SugaredLogger.With(resolveKeysAndValues(l.lazy)...)
+}
+
+func (l *Zap) child(sugar *zap.SugaredLogger, lazy []interface{}) *Zap {
+	if len(lazy) > 0 {
+		lazy = append(l.lazy[:len(l.lazy):len(l.lazy)], lazy...)
+	} else {
+		lazy = l.lazy
+	}
+
 	return &Zap{
+		SugaredLogger: sugar,
 		level:         l.level,
-		SugaredLogger: l.With(key, value),
+		lazy:          lazy,
+	}
+}
+
+// WithField implements Logger.WithField for Zap logger.
+func (l *Zap) WithField(key string, value interface{}) logger.Logger {
+	if _, ok := value.(*logger.LazyValue); ok {
+		return l.child(l.SugaredLogger, []interface{}{key, value})
 	}
+	return l.child(l.SugaredLogger.With(key, convertValue(value)), nil)
 }
 
 // WithFields implements Logger.WithFields for Zap logger.
 func (l *Zap) WithFields(fields map[string]interface{}) logger.Logger {
-	var f []interface{}
+	var f, lazy []interface{}
 	for key, value := range fields {
-		f = append(f, []interface{}{key, value}...)
+		if _, ok := value.(*logger.LazyValue); ok {
+			lazy = append(lazy, key, value)
+		} else {
+			f = append(f, key, convertValue(value))
+		}
 	}
 
-	return &Zap{
-		level:         l.level,
-		SugaredLogger: l.With(f...),
+	return l.child(l.SugaredLogger.With(f...), lazy)
+}
+
+// With implements Logger.With for Zap logger.
+// Fields are directly converted to zap fields, without
+// going through the sugared logger loosely typed API.
+func (l *Zap) With(fields ...logger.Field) logger.Logger {
+	var lazy []interface{}
+
+	fields = logger.ExpandErrorFields(fields)
+
+	zapFields := make([]zap.Field, 0, len(fields))
+	for _, field := range fields {
+		if _, ok := field.Interface.(*logger.LazyValue); ok {
+			lazy = append(lazy, field.Key, field.Interface)
+		} else {
+			zapFields = append(zapFields, convertField(field))
+		}
 	}
+
+	return l.child(l.Desugar().With(zapFields...).Sugar(), lazy)
 }
 
 // WithError implements Logger.WithError for Zap logger.
 func (l *Zap) WithError(err error) logger.Logger {
 	if err != nil {
-		return l.WithField(logger.FieldErrorKey, err.Error())
+		return l.With(logger.ErrorFields(err)...)
 	}
 	return l
 }
+
+// Named implements Logger.Named for Zap logger.
+func (l *Zap) Named(name string) logger.Logger {
+	return l.child(l.SugaredLogger.Named(name), nil)
+}
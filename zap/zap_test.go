@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	stdlog "log"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -160,12 +162,94 @@ func TestZap_WithFields(t *testing.T) {
 	}, output)
 }
 
+func TestZap_Named(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := &Zap{SugaredLogger: zap.NewExample().Sugar()}
+		log.Named("db").WithField("hello", "world").Named("pool").Warn("warn")
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":             zapcore.WarnLevel.String(),
+		"msg":               "warn",
+		"hello":             "world",
+		logger.FieldNameKey: "db.pool",
+	}, output)
+}
+
 func TestZap_WithError(t *testing.T) {
 	outputRaw, err := logger.CaptureOutput(func() {
 		log := &Zap{SugaredLogger: zap.NewExample().Sugar()}
 		log.
 			WithError(errors.New("eww1")).
-			WithError(errors.New("eww2")).
+			WithError(fmt.Errorf("eww2: %w", errors.New("cause"))).
+			Warn("warn")
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":                   zapcore.WarnLevel.String(),
+		"msg":                     "warn",
+		logger.FieldErrorKey:      "eww2: cause",
+		logger.FieldErrorTypeKey:  "*fmt.wrapError",
+		logger.FieldErrorChainKey: []interface{}{"cause"},
+	}, output)
+}
+
+func TestZap_Infow(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := &Zap{SugaredLogger: zap.NewExample().Sugar()}
+		log.WithField("hello", "world").Infow("info", "answer", 42)
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":  zapcore.InfoLevel.String(),
+		"msg":    "info",
+		"hello":  "world",
+		"answer": float64(42),
+	}, output)
+}
+
+func TestZap_Infow_badKeys(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.Encoding = "json"
+	config.EncoderConfig.TimeKey = ""
+	config.EncoderConfig.CallerKey = ""
+	config.OutputPaths = []string{"stdout"}
+
+	outputRaw, err := logger.CaptureOutput(func() {
+		log, _, err := New(WithZapConfig(config))
+		require.NoError(t, err)
+		log.Infow("m", "a", 1, 42, "x", "dangling")
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output), "a single entry should be written")
+	assert.Equal(t, map[string]interface{}{
+		"L":                "INFO",
+		"M":                "m",
+		"a":                float64(1),
+		"42":               "x",
+		logger.FieldBadKey: "dangling",
+	}, output)
+}
+
+func TestZap_With(t *testing.T) {
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := &Zap{SugaredLogger: zap.NewExample().Sugar()}
+		log.
+			With(logger.String("hello", "world"), logger.Int("answer", 42)).
+			With(logger.Bool("ok", true), logger.Float64("pi", 3.14), logger.Err(errors.New("eww"))).
+			With(logger.Duration("duration", time.Second), logger.Time("time", time.Unix(0, 0).UTC())).
+			With(logger.Any("list", []int{4, 2}), logger.Err(nil)).
 			Warn("warn")
 	})
 	require.NoError(t, err)
@@ -173,8 +257,149 @@ func TestZap_WithError(t *testing.T) {
 	var output map[string]interface{}
 	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
 	assert.Equal(t, map[string]interface{}{
-		"level":              zapcore.WarnLevel.String(),
-		"msg":                "warn",
-		logger.FieldErrorKey: "eww2",
+		"level":                  zapcore.WarnLevel.String(),
+		"msg":                    "warn",
+		"hello":                  "world",
+		"answer":                 float64(42),
+		"ok":                     true,
+		"pi":                     3.14,
+		logger.FieldErrorKey:     "eww",
+		logger.FieldErrorTypeKey: "*errors.errorString",
+		"duration":               "1s",
+		"time":                   "1970-01-01T00:00:00.000Z",
+		"list":                   []interface{}{float64(4), float64(2)},
+	}, output)
+}
+
+type user struct {
+	id       int64
+	password string
+}
+
+func (u user) MarshalLogObject(enc logger.ObjectEncoder) error {
+	enc.AddInt64("id", u.id)
+	return enc.AddObject("address", logger.ObjectMarshalerFunc(func(enc logger.ObjectEncoder) error {
+		return enc.AddAny("city", "Paris")
+	}))
+}
+
+func TestZap_object(t *testing.T) {
+	expectedUser := map[string]interface{}{
+		"id":      float64(42),
+		"address": map[string]interface{}{"city": "Paris"},
+	}
+
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := &Zap{SugaredLogger: zap.NewExample().Sugar()}
+		u := user{id: 42, password: "secret"}
+		log.
+			WithField("field", u).
+			WithFields(map[string]interface{}{"fields": u}).
+			With(logger.Object("typed", u)).
+			WithField("lazy", logger.Lazy(func() interface{} { return u })).
+			Warnw("warn", "kv", u)
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":  zapcore.WarnLevel.String(),
+		"msg":    "warn",
+		"field":  expectedUser,
+		"fields": expectedUser,
+		"typed":  expectedUser,
+		"lazy":   expectedUser,
+		"kv":     expectedUser,
+	}, output)
+}
+
+func newBenchmarkZap() *Zap {
+	return &Zap{SugaredLogger: zap.New(zapcore.NewCore(
+		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		zapcore.AddSync(io.Discard),
+		zapcore.DebugLevel,
+	)).Sugar()}
+}
+
+func BenchmarkZap_WithFields(b *testing.B) {
+	log := newBenchmarkZap()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		log.WithFields(map[string]interface{}{
+			"hello":  "world",
+			"answer": 42,
+		}).Info("info")
+	}
+}
+
+func BenchmarkZap_With(b *testing.B) {
+	log := newBenchmarkZap()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		log.With(logger.String("hello", "world"), logger.Int("answer", 42)).Info("info")
+	}
+}
+
+func TestZap_Enabled(t *testing.T) {
+	t.Run("with atomic level", func(t *testing.T) {
+		zLvl := zap.NewAtomicLevelAt(zapcore.WarnLevel)
+		log := Zap{level: &zLvl}
+
+		assert.Equal(t, logger.LevelWarn, log.Level())
+		assert.False(t, log.Enabled(logger.LevelInfo))
+		assert.True(t, log.Enabled(logger.LevelWarn))
+		assert.False(t, log.Enabled(logger.LevelQuiet))
+
+		zLvl.SetLevel(zapcore.FatalLevel)
+		assert.Equal(t, logger.LevelQuiet, log.Level())
+	})
+
+	t.Run("without atomic level", func(t *testing.T) {
+		log := Zap{SugaredLogger: zap.NewExample(zap.IncreaseLevel(zapcore.ErrorLevel)).Sugar()}
+
+		assert.Equal(t, logger.LevelError, log.Level())
+		assert.False(t, log.Enabled(logger.LevelWarn))
+		assert.True(t, log.Enabled(logger.LevelError))
+	})
+}
+
+func TestZap_lazy(t *testing.T) {
+	var calls int
+	lazy := logger.Lazy(func() interface{} {
+		calls++
+		return map[string]int{"answer": 42}
+	})
+
+	outputRaw, err := logger.CaptureOutput(func() {
+		log := &Zap{SugaredLogger: zap.NewExample(zap.IncreaseLevel(zapcore.InfoLevel)).Sugar()}
+		child := log.
+			WithField("lazy", lazy).
+			WithFields(map[string]interface{}{"lazies": lazy, "hello": "world"}).
+			With(logger.Any("typed", lazy))
+
+		child.Debug("debug")
+		child.Debugf("debug %v", lazy)
+		child.Debugw("debug", "lazy", lazy)
+		require.Equal(t, 0, calls)
+
+		child.Warnf("warn %v", lazy)
+	})
+	require.NoError(t, err)
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(outputRaw), &output))
+	assert.Equal(t, map[string]interface{}{
+		"level":  zapcore.WarnLevel.String(),
+		"msg":    "warn map[answer:42]",
+		"hello":  "world",
+		"lazy":   map[string]interface{}{"answer": float64(42)},
+		"lazies": map[string]interface{}{"answer": float64(42)},
+		"typed":  map[string]interface{}{"answer": float64(42)},
 	}, output)
+	assert.Equal(t, 1, calls)
 }